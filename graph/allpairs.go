@@ -0,0 +1,140 @@
+package graph
+
+import (
+	"fmt"
+	"math"
+)
+
+// ShortestPaths holds the shortest paths between every pair of nodes of a
+// graph. Nodes lists the graph's nodes, the rows and columns of Dist and Next
+// follow the same order.
+type ShortestPaths[K comparable] struct {
+	Nodes []K
+	// Dist holds the total weight of the shortest path from Nodes[i] to
+	// Nodes[j], or +Inf if there is no path between them. The distance from a
+	// node to itself is 0.
+	Dist [][]float64
+	// Next holds the index of the node that comes right after Nodes[i] on the
+	// shortest path to Nodes[j], or -1 if there is no path between them. As
+	// with FindShortestPath, a path always leaves its start node, so there is
+	// no path from a node to itself.
+	Next [][]int
+
+	index map[K]int
+}
+
+// AllPairsShortestPaths computes the shortest paths between every pair of
+// nodes using the Floyd–Warshall algorithm, taking edge weights into account.
+// It takes cubic time in the number of nodes, but afterwards any distance or
+// path can be read from the result without searching the graph again.
+func (g *Graph[K]) AllPairsShortestPaths() *ShortestPaths[K] {
+	n := len(g.nodes)
+	p := ShortestPaths[K]{
+		Nodes: make([]K, 0, n),
+		Dist:  make([][]float64, n),
+		Next:  make([][]int, n),
+		index: make(map[K]int, n),
+	}
+
+	for node := range g.nodes {
+		p.index[node] = len(p.Nodes)
+		p.Nodes = append(p.Nodes, node)
+	}
+
+	for i, node := range p.Nodes {
+		p.Dist[i] = make([]float64, n)
+		p.Next[i] = make([]int, n)
+		for j := range p.Dist[i] {
+			p.Dist[i][j] = math.Inf(1)
+			p.Next[i][j] = -1
+		}
+
+		p.Dist[i][i] = 0
+
+		for _, neighbor := range g.nodes[node] {
+			j := p.index[neighbor]
+			if weight := g.weight(node, neighbor); weight < p.Dist[i][j] {
+				p.Dist[i][j] = weight
+				p.Next[i][j] = j
+			}
+		}
+	}
+
+	for k := range n {
+		for i := range n {
+			if math.IsInf(p.Dist[i][k], 1) {
+				continue
+			}
+
+			for j := range n {
+				if candidate := p.Dist[i][k] + p.Dist[k][j]; candidate < p.Dist[i][j] {
+					p.Dist[i][j] = candidate
+					p.Next[i][j] = p.Next[i][k]
+				}
+			}
+		}
+	}
+
+	return &p
+}
+
+// Distance returns the total weight of the shortest path from node `a` to node
+// `b`, or +Inf if there is no path between them.
+func (p *ShortestPaths[K]) Distance(a, b K) (float64, error) {
+	i, j, err := p.lookup(a, b)
+	if err != nil {
+		return 0, err
+	}
+
+	return p.Dist[i][j], nil
+}
+
+// NextHop returns the node that comes right after node `a` on the shortest
+// path to node `b`. There is no path from a node to itself, see Next.
+func (p *ShortestPaths[K]) NextHop(a, b K) (K, error) {
+	i, j, err := p.lookup(a, b)
+	if err != nil {
+		var zero K
+		return zero, err
+	}
+	if p.Next[i][j] == -1 {
+		var zero K
+		return zero, fmt.Errorf("a path between node %v and node %v does not exist", a, b)
+	}
+
+	return p.Nodes[p.Next[i][j]], nil
+}
+
+// Path rebuilds the shortest path from node `a` to node `b` by following the
+// next hops, without searching the graph. As with FindShortestPath, a path
+// from a node to itself does not exist.
+func (p *ShortestPaths[K]) Path(a, b K) ([]K, error) {
+	i, j, err := p.lookup(a, b)
+	if err != nil {
+		return nil, err
+	}
+	if p.Next[i][j] == -1 {
+		return nil, fmt.Errorf("a path between node %v and node %v does not exist", a, b)
+	}
+
+	path := []K{a}
+	for i != j {
+		i = p.Next[i][j]
+		path = append(path, p.Nodes[i])
+	}
+
+	return path, nil
+}
+
+func (p *ShortestPaths[K]) lookup(a, b K) (int, int, error) {
+	i, ok := p.index[a]
+	if !ok {
+		return 0, 0, fmt.Errorf("start node %v does not exist in the graph", a)
+	}
+	j, ok := p.index[b]
+	if !ok {
+		return 0, 0, fmt.Errorf("end node %v does not exist in the graph", b)
+	}
+
+	return i, j, nil
+}
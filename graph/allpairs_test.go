@@ -0,0 +1,146 @@
+package graph
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestGraph_AllPairsShortestPaths(t *testing.T) {
+	g := IntGraph{
+		nodes: map[int][]int{
+			0:  {4, 6, 8},
+			1:  {2, 5, 8},
+			2:  {1, 3},
+			3:  {2, 7},
+			4:  {0, 5},
+			5:  {1, 4},
+			6:  {0, 7},
+			7:  {3, 6},
+			8:  {0, 1},
+			9:  {10},
+			10: {9},
+		},
+		weights: map[int]map[int]float64{
+			6: {7: 10},
+			7: {6: 10},
+		},
+	}
+
+	paths := g.AllPairsShortestPaths()
+
+	// Every pair must agree with a single pair search
+	for _, a := range paths.Nodes {
+		for _, b := range paths.Nodes {
+			wantPath, wantCost, err := g.FindShortestWeightedPath(a, b)
+
+			gotCost, _ := paths.Distance(a, b)
+			gotPath, gotErr := paths.Path(a, b)
+
+			// A node is at distance 0 from itself, but there is no path
+			// leaving it to come back
+			if a == b {
+				if gotCost != 0 || gotErr == nil {
+					t.Errorf("ShortestPaths from %d to itself = %v (%v), want no path at distance 0", a, gotPath, gotCost)
+				}
+				continue
+			}
+
+			if err != nil {
+				if !math.IsInf(gotCost, 1) || gotErr == nil {
+					t.Errorf("ShortestPaths from %d to %d = %v (%v), want no path", a, b, gotPath, gotCost)
+				}
+				continue
+			}
+
+			if gotCost != wantCost {
+				t.Errorf("ShortestPaths.Distance(%d, %d) = %v, want %v", a, b, gotCost, wantCost)
+			}
+			if gotErr != nil || len(gotPath) != len(wantPath) || pathCost(&g, gotPath) != wantCost {
+				t.Errorf("ShortestPaths.Path(%d, %d) = %v, want a path as cheap as %v", a, b, gotPath, wantPath)
+			}
+		}
+	}
+}
+
+func TestShortestPaths_Path(t *testing.T) {
+	type args struct {
+		a int
+		b int
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        []int
+		wantNextHop int
+		wantErr     bool
+	}{
+		{
+			name:        "Should rebuild the path from the next hops",
+			args:        args{a: 0, b: 3},
+			want:        []int{0, 1, 2, 3},
+			wantNextHop: 1,
+		},
+		{
+			name:    "Should return error for a path from a node to itself",
+			args:    args{a: 2, b: 2},
+			wantErr: true,
+		},
+		{
+			name:    "Should return error if a path does not exist",
+			args:    args{a: 0, b: 4},
+			wantErr: true,
+		},
+		{
+			name:    "Should return error if a node does not exist",
+			args:    args{a: 0, b: 9},
+			wantErr: true,
+		},
+	}
+
+	g := IntGraph{
+		nodes: map[int][]int{
+			0: {1, 3},
+			1: {0, 2},
+			2: {1, 3},
+			3: {0, 2},
+			4: {},
+		},
+		weights: map[int]map[int]float64{
+			0: {3: 5},
+			3: {0: 5},
+		},
+	}
+	paths := g.AllPairsShortestPaths()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := paths.Path(tt.args.a, tt.args.b)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ShortestPaths.Path() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ShortestPaths.Path() = %v, want %v", got, tt.want)
+			}
+
+			gotNextHop, err := paths.NextHop(tt.args.a, tt.args.b)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ShortestPaths.NextHop() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotNextHop != tt.wantNextHop {
+				t.Errorf("ShortestPaths.NextHop() = %v, want %v", gotNextHop, tt.wantNextHop)
+			}
+		})
+	}
+}
+
+func pathCost(g *IntGraph, path []int) float64 {
+	var cost float64
+	for i := 1; i < len(path); i++ {
+		cost += g.weight(path[i-1], path[i])
+	}
+
+	return cost
+}
@@ -0,0 +1,94 @@
+package graph
+
+import (
+	"container/heap"
+	"fmt"
+	"slices"
+)
+
+// Heuristic estimates the cost of the cheapest path from a node to the target
+// of an A* search. It must never overestimate that cost, or the path found is
+// not guaranteed to be the cheapest one.
+type Heuristic[K comparable] func(node K) float64
+
+// AStarResult is the outcome of an A* search.
+type AStarResult[K comparable] struct {
+	// Path holds the nodes from the start node to the target, both included.
+	Path []K
+	// Cost is the total weight of the edges along the path.
+	Cost float64
+	// Expanded is the number of nodes whose neighbors were looked at before
+	// the target was reached.
+	Expanded int
+}
+
+// FindShortestPathAStar returns the path between node `a` and node `b` with the
+// lowest total weight using the A* algorithm, which explores nodes in the
+// order of their distance from `a` plus the heuristic estimate of their
+// distance to `b`. Edges added without a weight weigh 1. A nil heuristic is
+// treated as one always returning 0, in which case the search is exactly
+// Dijkstra's algorithm. As with FindShortestPath, the path always leaves node
+// `a`, so a path from a node to itself does not exist.
+func (g *Graph[K]) FindShortestPathAStar(a, b K, heuristic Heuristic[K]) (AStarResult[K], error) {
+	if _, ok := g.nodes[a]; !ok {
+		return AStarResult[K]{}, fmt.Errorf("start node %v does not exist in the graph", a)
+	}
+	if _, ok := g.nodes[b]; !ok {
+		return AStarResult[K]{}, fmt.Errorf("end node %v does not exist in the graph", b)
+	}
+	if a == b {
+		return AStarResult[K]{}, fmt.Errorf("a path between node %v and node %v does not exist", a, b)
+	}
+	if heuristic == nil {
+		heuristic = func(K) float64 { return 0 }
+	}
+
+	dist := map[K]float64{a: 0}
+	estimate := map[K]float64{a: heuristic(a)}
+	prev := make(map[K]K)
+	expanded := 0
+
+	queue := &priorityQueue[K]{{node: a, priority: estimate[a]}}
+	for queue.Len() > 0 {
+		item := heap.Pop(queue).(queueItem[K])
+
+		// Stale entry, the node was queued again through a cheaper path. A
+		// node may still be expanded more than once if the heuristic is not
+		// consistent, which keeps the result correct for any admissible one.
+		if item.priority > dist[item.node]+estimate[item.node] {
+			continue
+		}
+
+		// Reached the target
+		if item.node == b {
+			path := []K{b}
+			for node := b; node != a; {
+				node = prev[node]
+				path = append(path, node)
+			}
+
+			slices.Reverse(path)
+
+			return AStarResult[K]{Path: path, Cost: dist[b], Expanded: expanded}, nil
+		}
+
+		expanded++
+
+		for _, neighbor := range g.nodes[item.node] {
+			candidate := dist[item.node] + g.weight(item.node, neighbor)
+			if current, ok := dist[neighbor]; ok && current <= candidate {
+				continue
+			}
+
+			if _, ok := estimate[neighbor]; !ok {
+				estimate[neighbor] = heuristic(neighbor)
+			}
+
+			dist[neighbor] = candidate
+			prev[neighbor] = item.node
+			heap.Push(queue, queueItem[K]{node: neighbor, priority: candidate + estimate[neighbor]})
+		}
+	}
+
+	return AStarResult[K]{Expanded: expanded}, fmt.Errorf("a path between node %v and node %v does not exist", a, b)
+}
@@ -0,0 +1,205 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+// gridGraph returns a width × height grid where node y*width+x is connected
+// to the nodes next to it horizontally and vertically.
+func gridGraph(width, height int) IntGraph {
+	nodes := make(map[int][]int)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			node := y*width + x
+			nodes[node] = []int{}
+			if x > 0 {
+				nodes[node] = append(nodes[node], node-1)
+			}
+			if x < width-1 {
+				nodes[node] = append(nodes[node], node+1)
+			}
+			if y > 0 {
+				nodes[node] = append(nodes[node], node-width)
+			}
+			if y < height-1 {
+				nodes[node] = append(nodes[node], node+width)
+			}
+		}
+	}
+
+	return IntGraph{nodes: nodes}
+}
+
+// manhattan returns a heuristic estimating the distance to `target` on a grid
+// of the given width.
+func manhattan(width, target int) Heuristic[int] {
+	abs := func(v int) int {
+		if v < 0 {
+			return -v
+		}
+		return v
+	}
+
+	return func(node int) float64 {
+		return float64(abs(node%width-target%width) + abs(node/width-target/width))
+	}
+}
+
+func TestGraph_FindShortestPathAStar(t *testing.T) {
+	type args struct {
+		a         int
+		b         int
+		heuristic Heuristic[int]
+	}
+	tests := []struct {
+		name     string
+		graph    IntGraph
+		args     args
+		want     []int
+		wantCost float64
+		wantErr  bool
+	}{
+		{
+			name: "Should behave like Dijkstra's algorithm without a heuristic",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {4, 6, 8},
+					1: {2, 5, 8},
+					2: {1, 3},
+					3: {2, 7},
+					4: {0, 5},
+					5: {1, 4},
+					6: {0, 7},
+					7: {3, 6},
+					8: {0, 1},
+				},
+				weights: map[int]map[int]float64{
+					6: {7: 10},
+					7: {6: 10},
+				},
+			},
+			args:     args{a: 0, b: 3},
+			want:     []int{0, 8, 1, 2, 3},
+			wantCost: 4,
+		},
+		{
+			name:     "Should follow the heuristic straight to the target on a grid",
+			graph:    gridGraph(5, 1),
+			args:     args{a: 0, b: 4, heuristic: manhattan(5, 4)},
+			want:     []int{0, 1, 2, 3, 4},
+			wantCost: 4,
+		},
+		{
+			name: "Should go around an expensive edge the heuristic does not know about",
+			graph: func() IntGraph {
+				g := gridGraph(3, 2)
+				g.weights = map[int]map[int]float64{
+					1: {2: 5},
+					2: {1: 5},
+				}
+				return g
+			}(),
+			args:     args{a: 0, b: 2, heuristic: manhattan(3, 2)},
+			want:     []int{0, 1, 4, 5, 2},
+			wantCost: 4,
+		},
+		{
+			name: "Should find the cheapest path with an admissible but inconsistent heuristic",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1, 2},
+					1: {0, 3},
+					2: {0, 3},
+					3: {1, 2, 4},
+					4: {3},
+				},
+				weights: map[int]map[int]float64{
+					0: {1: 1, 2: 2},
+					1: {0: 1, 3: 2},
+					2: {0: 2, 3: 0.5},
+					3: {1: 2, 2: 0.5, 4: 3},
+					4: {3: 3},
+				},
+			},
+			args: args{a: 0, b: 4, heuristic: func(node int) float64 {
+				// Overly optimistic about nodes 1 and 3, so node 3 is first
+				// expanded through node 1 and has to be expanded again
+				return map[int]float64{0: 5, 1: 0, 2: 3.5, 3: 0, 4: 0}[node]
+			}},
+			want:     []int{0, 2, 3, 4},
+			wantCost: 5.5,
+		},
+		{
+			name:    "Should return error if both ends are the same",
+			graph:   gridGraph(2, 2),
+			args:    args{a: 3, b: 3, heuristic: manhattan(2, 3)},
+			wantErr: true,
+		},
+		{
+			name:    "Should return error if starting node does not exist",
+			graph:   gridGraph(2, 2),
+			args:    args{a: 9, b: 0},
+			wantErr: true,
+		},
+		{
+			name:    "Should return error if ending node does not exist",
+			graph:   gridGraph(2, 2),
+			args:    args{a: 0, b: 9},
+			wantErr: true,
+		},
+		{
+			name: "Should return error if a path does not exist",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1},
+					1: {0},
+					2: {},
+				},
+			},
+			args:    args{a: 0, b: 2},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.graph.FindShortestPathAStar(tt.args.a, tt.args.b, tt.args.heuristic)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Graph.FindShortestPathAStar() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got.Path, tt.want) {
+				t.Errorf("Graph.FindShortestPathAStar() path = %v, want %v", got.Path, tt.want)
+			}
+			if got.Cost != tt.wantCost {
+				t.Errorf("Graph.FindShortestPathAStar() cost = %v, want %v", got.Cost, tt.wantCost)
+			}
+		})
+	}
+}
+
+func TestGraph_FindShortestPathAStarExpanded(t *testing.T) {
+	g := gridGraph(20, 20)
+	target := 19*20 + 19
+
+	informed, err := g.FindShortestPathAStar(0, target, manhattan(20, target))
+	if err != nil {
+		t.Fatalf("Graph.FindShortestPathAStar() error = %v", err)
+	}
+	uninformed, err := g.FindShortestPathAStar(0, target, nil)
+	if err != nil {
+		t.Fatalf("Graph.FindShortestPathAStar() error = %v", err)
+	}
+
+	if informed.Cost != 38 || uninformed.Cost != 38 {
+		t.Errorf("Graph.FindShortestPathAStar() cost = %v and %v, want 38", informed.Cost, uninformed.Cost)
+	}
+
+	// Without a heuristic every node closer than the target is expanded
+	if uninformed.Expanded < len(g.nodes)-1 {
+		t.Errorf("Graph.FindShortestPathAStar() expanded %v nodes without heuristic, want at least %v", uninformed.Expanded, len(g.nodes)-1)
+	}
+	if informed.Expanded >= uninformed.Expanded {
+		t.Errorf("Graph.FindShortestPathAStar() expanded %v nodes with heuristic, want fewer than %v", informed.Expanded, uninformed.Expanded)
+	}
+}
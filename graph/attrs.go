@@ -0,0 +1,197 @@
+package graph
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// SetNodeAttr stores an attribute of a node under the given key, replacing
+// any previous value. Attributes are removed together with their node.
+func (g *Graph[K]) SetNodeAttr(nodeID K, key string, value any) error {
+	if g.nodes[nodeID] == nil {
+		return fmt.Errorf("node %v does not exist", nodeID)
+	}
+
+	if g.nodeAttrs == nil {
+		g.nodeAttrs = make(map[K]map[string]any)
+	}
+	if g.nodeAttrs[nodeID] == nil {
+		g.nodeAttrs[nodeID] = make(map[string]any)
+	}
+
+	g.nodeAttrs[nodeID][key] = value
+
+	return nil
+}
+
+// DeleteNodeAttr removes an attribute of a node, only returns error if the
+// node does not exist.
+func (g *Graph[K]) DeleteNodeAttr(nodeID K, key string) error {
+	if g.nodes[nodeID] == nil {
+		return fmt.Errorf("node %v does not exist", nodeID)
+	}
+
+	delete(g.nodeAttrs[nodeID], key)
+
+	return nil
+}
+
+// NodeAttrKeys returns the sorted keys of the attributes a node has.
+func (g *Graph[K]) NodeAttrKeys(nodeID K) []string {
+	return slices.Sorted(maps.Keys(g.nodeAttrs[nodeID]))
+}
+
+// NodeAttr returns an attribute of a node as a value of type V, returning an
+// error if the node has no such attribute or it is of a different type.
+func NodeAttr[V any, K comparable](g *Graph[K], nodeID K, key string) (V, error) {
+	value, ok := g.nodeAttrs[nodeID][key]
+	if !ok {
+		var zero V
+		return zero, fmt.Errorf("node %v has no attribute %q", nodeID, key)
+	}
+
+	attr, err := decodeAttr[V](value)
+	if err != nil {
+		return attr, fmt.Errorf("attribute %q of node %v: %w", key, nodeID, err)
+	}
+
+	return attr, nil
+}
+
+// SetEdgeAttr stores an attribute of the edge between node `a` and node `b`
+// under the given key, replacing any previous value. Parallel edges share
+// their attributes. Attributes are removed together with their edge.
+func (g *Graph[K]) SetEdgeAttr(a, b K, key string, value any) error {
+	if !slices.Contains(g.nodes[a], b) {
+		return fmt.Errorf("connection between node %v and node %v does not exist", a, b)
+	}
+
+	g.setEdgeAttr(a, b, key, value)
+	g.setEdgeAttr(b, a, key, value)
+
+	return nil
+}
+
+func (g *Graph[K]) setEdgeAttr(a, b K, key string, value any) {
+	if g.edgeAttrs == nil {
+		g.edgeAttrs = make(map[K]map[K]map[string]any)
+	}
+	if g.edgeAttrs[a] == nil {
+		g.edgeAttrs[a] = make(map[K]map[string]any)
+	}
+	if g.edgeAttrs[a][b] == nil {
+		g.edgeAttrs[a][b] = make(map[string]any)
+	}
+
+	g.edgeAttrs[a][b][key] = value
+}
+
+// DeleteEdgeAttr removes an attribute of the edge between node `a` and node
+// `b`, only returns error if the edge does not exist.
+func (g *Graph[K]) DeleteEdgeAttr(a, b K, key string) error {
+	if !slices.Contains(g.nodes[a], b) {
+		return fmt.Errorf("connection between node %v and node %v does not exist", a, b)
+	}
+
+	delete(g.edgeAttrs[a][b], key)
+	delete(g.edgeAttrs[b][a], key)
+
+	return nil
+}
+
+// EdgeAttrKeys returns the sorted keys of the attributes the edge between node
+// `a` and node `b` has.
+func (g *Graph[K]) EdgeAttrKeys(a, b K) []string {
+	return slices.Sorted(maps.Keys(g.edgeAttrs[a][b]))
+}
+
+// EdgeAttr returns an attribute of the edge between node `a` and node `b` as a
+// value of type V, returning an error if the edge has no such attribute or it
+// is of a different type.
+func EdgeAttr[V any, K comparable](g *Graph[K], a, b K, key string) (V, error) {
+	value, ok := g.edgeAttrs[a][b][key]
+	if !ok {
+		var zero V
+		return zero, fmt.Errorf("edge between node %v and node %v has no attribute %q", a, b, key)
+	}
+
+	attr, err := decodeAttr[V](value)
+	if err != nil {
+		return attr, fmt.Errorf("attribute %q of edge between node %v and node %v: %w", key, a, b, err)
+	}
+
+	return attr, nil
+}
+
+// removeEdgeAttrs drops the attributes of every edge between node `a` and node
+// `b`.
+func (g *Graph[K]) removeEdgeAttrs(a, b K) {
+	delete(g.edgeAttrs[a], b)
+	delete(g.edgeAttrs[b], a)
+}
+
+// jsonAttr is an attribute value read from JSON, it is only decoded once the
+// type it is requested as is known.
+type jsonAttr json.RawMessage
+
+// textAttr is an attribute value read from DOT, which has no types. It is
+// returned as is if requested as a string, and decoded as JSON otherwise, so
+// that numbers and booleans can be read back.
+type textAttr string
+
+func decodeAttr[V any](value any) (V, error) {
+	var attr V
+
+	switch v := value.(type) {
+	case jsonAttr:
+		err := json.Unmarshal(v, &attr)
+		if err != nil {
+			return attr, fmt.Errorf("unable to decode %s as %T: %w", v, attr, err)
+		}
+		return attr, nil
+	case textAttr:
+		if s, ok := any(string(v)).(V); ok {
+			return s, nil
+		}
+		err := json.Unmarshal([]byte(v), &attr)
+		if err != nil {
+			return attr, fmt.Errorf("unable to decode %q as %T: %w", string(v), attr, err)
+		}
+		return attr, nil
+	case V:
+		return v, nil
+	}
+
+	return attr, fmt.Errorf("value is of type %T, not %T", value, attr)
+}
+
+// encodableAttr turns an attribute value into one that encodes back to the
+// same JSON it was read from.
+func encodableAttr(value any) any {
+	switch v := value.(type) {
+	case jsonAttr:
+		return json.RawMessage(v)
+	case textAttr:
+		return string(v)
+	}
+
+	return value
+}
+
+// textualAttr formats an attribute value for DOT.
+func textualAttr(value any) string {
+	switch v := value.(type) {
+	case jsonAttr:
+		var s string
+		if json.Unmarshal(v, &s) == nil {
+			return s
+		}
+		return string(v)
+	case textAttr:
+		return string(v)
+	}
+
+	return fmt.Sprint(value)
+}
@@ -0,0 +1,232 @@
+package graph
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestNodeAttr(t *testing.T) {
+	g := IntGraph{
+		nodes: map[int][]int{
+			0: {1},
+			1: {0},
+		},
+	}
+
+	if err := g.SetNodeAttr(0, "name", "gateway"); err != nil {
+		t.Fatalf("Graph.SetNodeAttr() error = %v", err)
+	}
+	if err := g.SetNodeAttr(0, "capacity", 40); err != nil {
+		t.Fatalf("Graph.SetNodeAttr() error = %v", err)
+	}
+	if err := g.SetNodeAttr(5, "name", "missing"); err == nil {
+		t.Errorf("Graph.SetNodeAttr() expected error for a non-existent node")
+	}
+
+	name, err := NodeAttr[string](&g, 0, "name")
+	if err != nil || name != "gateway" {
+		t.Errorf("NodeAttr[string]() = %q, %v, want %q", name, err, "gateway")
+	}
+
+	capacity, err := NodeAttr[int](&g, 0, "capacity")
+	if err != nil || capacity != 40 {
+		t.Errorf("NodeAttr[int]() = %v, %v, want 40", capacity, err)
+	}
+
+	if _, err := NodeAttr[string](&g, 0, "capacity"); err == nil {
+		t.Errorf("NodeAttr[string]() expected error for an int attribute")
+	}
+	if _, err := NodeAttr[string](&g, 1, "name"); err == nil {
+		t.Errorf("NodeAttr[string]() expected error for a missing attribute")
+	}
+
+	if keys := g.NodeAttrKeys(0); !reflect.DeepEqual(keys, []string{"capacity", "name"}) {
+		t.Errorf("Graph.NodeAttrKeys() = %v, want [capacity name]", keys)
+	}
+
+	if err := g.DeleteNodeAttr(0, "name"); err != nil {
+		t.Fatalf("Graph.DeleteNodeAttr() error = %v", err)
+	}
+	if _, err := NodeAttr[string](&g, 0, "name"); err == nil {
+		t.Errorf("NodeAttr[string]() expected error for a deleted attribute")
+	}
+}
+
+func TestEdgeAttr(t *testing.T) {
+	g := IntGraph{
+		nodes: map[int][]int{
+			0: {1},
+			1: {0},
+			2: {},
+		},
+	}
+
+	if err := g.SetEdgeAttr(0, 1, "color", "red"); err != nil {
+		t.Fatalf("Graph.SetEdgeAttr() error = %v", err)
+	}
+	if err := g.SetEdgeAttr(0, 2, "color", "blue"); err == nil {
+		t.Errorf("Graph.SetEdgeAttr() expected error for a non-existent edge")
+	}
+
+	// Edges are undirected, so the attribute is seen from both ends
+	color, err := EdgeAttr[string](&g, 1, 0, "color")
+	if err != nil || color != "red" {
+		t.Errorf("EdgeAttr[string]() = %q, %v, want %q", color, err, "red")
+	}
+
+	if err := g.DeleteEdgeAttr(1, 0, "color"); err != nil {
+		t.Fatalf("Graph.DeleteEdgeAttr() error = %v", err)
+	}
+	if _, err := EdgeAttr[string](&g, 0, 1, "color"); err == nil {
+		t.Errorf("EdgeAttr[string]() expected error for a deleted attribute")
+	}
+}
+
+func TestGraph_AttrsFollowMutations(t *testing.T) {
+	g, err := New(map[int][]int{
+		0: {1},
+		1: {0, 2},
+		2: {1},
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	_ = g.SetNodeAttr(1, "name", "hub")
+	_ = g.SetEdgeAttr(0, 1, "color", "red")
+	_ = g.SetEdgeAttr(1, 2, "color", "blue")
+
+	clone := g.Clone()
+
+	if err := g.RemoveEdge(0, 1); err != nil {
+		t.Fatalf("Graph.RemoveEdge() error = %v", err)
+	}
+	if err := g.AddEdge(0, 1); err != nil {
+		t.Fatalf("Graph.AddEdge() error = %v", err)
+	}
+	if _, err := EdgeAttr[string](g, 0, 1, "color"); err == nil {
+		t.Errorf("EdgeAttr() kept the attribute of a removed edge")
+	}
+
+	if err := g.RemoveNode(1); err != nil {
+		t.Fatalf("Graph.RemoveNode() error = %v", err)
+	}
+	if err := g.AddNode(1, []int{2}); err != nil {
+		t.Fatalf("Graph.AddNode() error = %v", err)
+	}
+	if _, err := NodeAttr[string](g, 1, "name"); err == nil {
+		t.Errorf("NodeAttr() kept the attribute of a removed node")
+	}
+	if _, err := EdgeAttr[string](g, 1, 2, "color"); err == nil {
+		t.Errorf("EdgeAttr() kept the attribute of an edge of a removed node")
+	}
+
+	// The clone is not affected by any of it
+	if name, err := NodeAttr[string](clone, 1, "name"); err != nil || name != "hub" {
+		t.Errorf("cloned NodeAttr() = %q, %v, want %q", name, err, "hub")
+	}
+	if color, err := EdgeAttr[string](clone, 2, 1, "color"); err != nil || color != "blue" {
+		t.Errorf("cloned EdgeAttr() = %q, %v, want %q", color, err, "blue")
+	}
+}
+
+func TestGraph_AttrsJSONRoundTrip(t *testing.T) {
+	type position struct {
+		X, Y float64
+	}
+
+	g, err := New(map[string][]string{
+		"a": {"b"},
+		"b": {"a"},
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	_ = g.SetNodeAttr("a", "capacity", 3)
+	_ = g.SetNodeAttr("a", "position", position{X: 1, Y: 2.5})
+	_ = g.SetEdgeAttr("a", "b", "label", "uplink")
+
+	data, err := json.Marshal(g)
+	if err != nil {
+		t.Fatalf("Graph.MarshalJSON() error = %v", err)
+	}
+
+	var got Graph[string]
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Graph.UnmarshalJSON() error = %v", err)
+	}
+
+	if capacity, err := NodeAttr[int](&got, "a", "capacity"); err != nil || capacity != 3 {
+		t.Errorf("decoded NodeAttr[int]() = %v, %v, want 3", capacity, err)
+	}
+	if pos, err := NodeAttr[position](&got, "a", "position"); err != nil || pos != (position{X: 1, Y: 2.5}) {
+		t.Errorf("decoded NodeAttr[position]() = %v, %v, want {1 2.5}", pos, err)
+	}
+	if label, err := EdgeAttr[string](&got, "b", "a", "label"); err != nil || label != "uplink" {
+		t.Errorf("decoded EdgeAttr[string]() = %q, %v, want %q", label, err, "uplink")
+	}
+
+	// Encoding the decoded graph gives back the same JSON
+	again, err := json.Marshal(&got)
+	if err != nil {
+		t.Fatalf("Graph.MarshalJSON() error = %v", err)
+	}
+	if !bytes.Equal(again, data) {
+		t.Errorf("Graph.MarshalJSON() = %s, want %s", again, data)
+	}
+}
+
+func TestGraph_AttrsDOTRoundTrip(t *testing.T) {
+	g, err := New(map[int][]int{
+		0: {},
+		1: {},
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	_ = g.AddWeightedEdge(0, 1, 2)
+	_ = g.SetNodeAttr(0, "label", "load balancer")
+	_ = g.SetNodeAttr(0, "capacity", 12)
+	_ = g.SetEdgeAttr(0, 1, "color", "red")
+
+	var buf bytes.Buffer
+	if err := g.WriteDOT(&buf); err != nil {
+		t.Fatalf("Graph.WriteDOT() error = %v", err)
+	}
+
+	want := `graph {
+	0 [capacity=12, label="load balancer"];
+	1;
+	0 -- 1 [weight=2, color=red];
+}
+`
+	if buf.String() != want {
+		t.Errorf("Graph.WriteDOT() = %s, want %s", buf.String(), want)
+	}
+
+	got, err := ReadDOT(&buf, strconv.Atoi)
+	if err != nil {
+		t.Fatalf("ReadDOT() error = %v", err)
+	}
+
+	if label, err := NodeAttr[string](got, 0, "label"); err != nil || label != "load balancer" {
+		t.Errorf("read NodeAttr[string]() = %q, %v, want %q", label, err, "load balancer")
+	}
+	if capacity, err := NodeAttr[int](got, 0, "capacity"); err != nil || capacity != 12 {
+		t.Errorf("read NodeAttr[int]() = %v, %v, want 12", capacity, err)
+	}
+	if color, err := EdgeAttr[string](got, 1, 0, "color"); err != nil || color != "red" {
+		t.Errorf("read EdgeAttr[string]() = %q, %v, want %q", color, err, "red")
+	}
+	if weight, _ := got.Weight(0, 1); weight != 2 {
+		t.Errorf("read Graph.Weight() = %v, want 2", weight)
+	}
+	if _, err := EdgeAttr[string](got, 0, 1, "weight"); err == nil {
+		t.Errorf("read EdgeAttr() kept weight as an attribute")
+	}
+}
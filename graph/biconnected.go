@@ -0,0 +1,146 @@
+package graph
+
+import "slices"
+
+// ArticulationPoints returns the nodes whose removal would split their
+// connected component in two or more, ordered as when encoding the graph.
+func (g *Graph[K]) ArticulationPoints() []K {
+	return g.biconnected().points
+}
+
+// Bridges returns the edges whose removal would split their connected
+// component in two, each with the smaller node first, ordered as when encoding
+// the graph. An edge with a parallel edge next to it is never a bridge.
+func (g *Graph[K]) Bridges() []Edge[K] {
+	return g.biconnected().bridges
+}
+
+// BiconnectedComponents returns the biconnected components of the graph, the
+// largest sets of nodes that stay connected after removing any single node.
+// Every edge belongs to exactly one component, while an articulation point
+// belongs to every component it joins. A node with no edges, self-loops aside,
+// forms a component of its own.
+func (g *Graph[K]) BiconnectedComponents() []NodeSet[K] {
+	return g.biconnected().components
+}
+
+type biconnectivity[K comparable] struct {
+	points     []K
+	bridges    []Edge[K]
+	components []NodeSet[K]
+}
+
+// biconnected runs Tarjan's depth-first search, which finds articulation
+// points, bridges and biconnected components at once in linear time.
+func (g *Graph[K]) biconnected() biconnectivity[K] {
+	var result biconnectivity[K]
+
+	// discovered holds the order the search reached every node in, and low
+	// the earliest node reachable from the node's subtree with a single edge
+	// leading back up the tree
+	discovered := make(map[K]int, len(g.nodes))
+	low := make(map[K]int, len(g.nodes))
+	isPoint := make(map[K]bool)
+	var edges []Edge[K]
+
+	var visit func(node, parent K, hasParent bool)
+	visit = func(node, parent K, hasParent bool) {
+		discovered[node] = len(discovered)
+		low[node] = discovered[node]
+
+		children := 0
+		skippedParent := false
+		for _, neighbor := range g.nodes[node] {
+			if neighbor == node {
+				continue
+			}
+
+			// This is the edge we came from, any other edge to the parent
+			// is a parallel edge leading back up the tree
+			if hasParent && neighbor == parent && !skippedParent {
+				skippedParent = true
+				continue
+			}
+
+			order, seen := discovered[neighbor]
+			if seen {
+				// Only edges leading up the tree count, the others were seen
+				// from their lower end already
+				if order < discovered[node] {
+					edges = append(edges, Edge[K]{A: node, B: neighbor})
+					low[node] = min(low[node], order)
+				}
+				continue
+			}
+
+			children++
+			edges = append(edges, Edge[K]{A: node, B: neighbor})
+			visit(neighbor, node, true)
+			low[node] = min(low[node], low[neighbor])
+
+			// Nothing below the neighbor leads above it, so the edge is the
+			// only way there
+			if low[neighbor] > discovered[node] {
+				result.bridges = append(result.bridges, Edge[K]{A: node, B: neighbor})
+			}
+
+			// Nothing below the neighbor leads above this node, so it splits
+			// off the neighbor's subtree along with the edges collected there
+			if low[neighbor] >= discovered[node] {
+				if hasParent {
+					isPoint[node] = true
+				}
+
+				component := make(NodeSet[K])
+				for {
+					edge := edges[len(edges)-1]
+					edges = edges[:len(edges)-1]
+					component[edge.A] = struct{}{}
+					component[edge.B] = struct{}{}
+
+					if edge == (Edge[K]{A: node, B: neighbor}) {
+						break
+					}
+				}
+				result.components = append(result.components, component)
+			}
+		}
+
+		// The root of the search only splits the graph if it has more than
+		// one subtree
+		if !hasParent && children > 1 {
+			isPoint[node] = true
+		}
+		if !hasParent && children == 0 {
+			result.components = append(result.components, NodeSet[K]{node: {}})
+		}
+	}
+
+	for _, node := range g.sortedNodes() {
+		if _, seen := discovered[node]; seen {
+			continue
+		}
+
+		var noParent K
+		visit(node, noParent, false)
+	}
+
+	for node := range isPoint {
+		result.points = append(result.points, node)
+	}
+	slices.SortFunc(result.points, compareNodes)
+
+	for i, bridge := range result.bridges {
+		if compareNodes(bridge.A, bridge.B) > 0 {
+			result.bridges[i] = Edge[K]{A: bridge.B, B: bridge.A}
+		}
+	}
+	slices.SortFunc(result.bridges, func(x, y Edge[K]) int {
+		if c := compareNodes(x.A, y.A); c != 0 {
+			return c
+		}
+		return compareNodes(x.B, y.B)
+	})
+
+	return result
+}
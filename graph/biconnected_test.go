@@ -0,0 +1,146 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+// servicesGraph has two triangles sharing node 2 and a chain 4-5-6
+// hanging off node 3, and a separate pair of nodes 7 and 8 joined by two
+// parallel edges.
+func servicesGraph() IntGraph {
+	return IntGraph{
+		nodes: map[int][]int{
+			0: {1, 2},
+			1: {0, 2},
+			2: {0, 1, 3, 9},
+			3: {2, 4, 9},
+			4: {3, 5},
+			5: {4, 6},
+			6: {5},
+			7: {8, 8},
+			8: {7, 7},
+			9: {2, 3},
+		},
+		multiEdges: true,
+	}
+}
+
+func TestGraph_ArticulationPoints(t *testing.T) {
+	tests := []struct {
+		name  string
+		graph IntGraph
+		want  []int
+	}{
+		{
+			name:  "Should find nodes joining parts of the graph",
+			graph: servicesGraph(),
+			want:  []int{2, 3, 4, 5},
+		},
+		{
+			name: "Should find the root of the search if it has several subtrees",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1, 2},
+					1: {0},
+					2: {0},
+				},
+			},
+			want: []int{0},
+		},
+		{
+			name: "Should return no nodes for a cycle",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1, 2},
+					1: {0, 2},
+					2: {0, 1},
+				},
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.graph.ArticulationPoints(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Graph.ArticulationPoints() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGraph_Bridges(t *testing.T) {
+	tests := []struct {
+		name  string
+		graph IntGraph
+		want  []Edge[int]
+	}{
+		{
+			name:  "Should find edges joining parts of the graph",
+			graph: servicesGraph(),
+			want:  []Edge[int]{{A: 3, B: 4}, {A: 4, B: 5}, {A: 5, B: 6}},
+		},
+		{
+			name: "Should ignore self-loops",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {0, 0, 1},
+					1: {0},
+				},
+				selfLoops: true,
+			},
+			want: []Edge[int]{{A: 0, B: 1}},
+		},
+		{
+			name: "Should return no edges for a cycle",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1, 2},
+					1: {0, 2},
+					2: {0, 1},
+				},
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.graph.Bridges(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Graph.Bridges() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGraph_BiconnectedComponents(t *testing.T) {
+	tests := []struct {
+		name  string
+		graph IntGraph
+		want  [][]int
+	}{
+		{
+			name:  "Should split the graph at articulation points",
+			graph: servicesGraph(),
+			want:  [][]int{{0, 1, 2}, {2, 3, 9}, {3, 4}, {4, 5}, {5, 6}, {7, 8}},
+		},
+		{
+			name: "Should put a node without edges in a component of its own",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1},
+					1: {0},
+					2: {2, 2},
+				},
+				selfLoops: true,
+			},
+			want: [][]int{{0, 1}, {2}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sortedComponents(tt.graph.BiconnectedComponents()); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Graph.BiconnectedComponents() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
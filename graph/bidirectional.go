@@ -0,0 +1,109 @@
+package graph
+
+import "fmt"
+
+// FindShortestPathBidirectional returns the shortest path between node `a`
+// and node `b`, like FindShortestPath, but grows a breadth-first frontier from
+// both ends and stops as soon as they meet. On large graphs this visits far
+// fewer nodes, since each search only goes half of the way. It returns the
+// same results as FindShortestPath, so a path from a node to itself does not
+// exist. If multiple same length paths exist, it will return one of them
+// without any defined logic.
+func (g *Graph[K]) FindShortestPathBidirectional(a, b K) ([]K, error) {
+	if _, ok := g.nodes[a]; !ok {
+		return nil, fmt.Errorf("start node %v does not exist in the graph", a)
+	}
+	if _, ok := g.nodes[b]; !ok {
+		return nil, fmt.Errorf("end node %v does not exist in the graph", b)
+	}
+
+	// Like FindShortestPath, the path always leaves node `a`
+	if a == b {
+		return nil, fmt.Errorf("a path between node %v and node %v does not exist", a, b)
+	}
+
+	forward := newFrontier(a)
+	backward := newFrontier(b)
+	for len(forward.queue) > 0 && len(backward.queue) > 0 {
+		// Always grow the smaller frontier, which keeps both searches balanced
+		// when one of the ends sits in a much denser part of the graph
+		grow, other := forward, backward
+		if len(backward.queue) < len(forward.queue) {
+			grow, other = backward, forward
+		}
+
+		meet, ok := g.growFrontier(grow, other)
+		if !ok {
+			continue
+		}
+
+		path := pathTo(forward.prev, a, meet)
+		for node := meet; node != b; {
+			node = backward.prev[node]
+			path = append(path, node)
+		}
+
+		return path, nil
+	}
+
+	return nil, fmt.Errorf("a path between node %v and node %v does not exist", a, b)
+}
+
+// frontier is one side of a bidirectional search.
+type frontier[K comparable] struct {
+	// prev holds every node seen with the node it was reached from
+	prev map[K]K
+	// dist holds the number of edges from the search origin to every node seen
+	dist map[K]int
+	// queue holds the nodes of the last complete layer
+	queue []K
+}
+
+func newFrontier[K comparable](origin K) *frontier[K] {
+	return &frontier[K]{
+		prev:  map[K]K{origin: origin},
+		dist:  map[K]int{origin: 0},
+		queue: []K{origin},
+	}
+}
+
+// growFrontier expands a whole layer of the `grow` frontier and returns the
+// node through which the shortest path passes if the layer reached nodes seen
+// by the `other` one. The whole layer is expanded before deciding, since the
+// first meeting found is not necessarily on the shortest path.
+func (g *Graph[K]) growFrontier(grow, other *frontier[K]) (K, bool) {
+	var meet K
+	best := -1
+
+	var next []K
+	for _, node := range grow.queue {
+		for _, neighbor := range g.nodes[node] {
+			if d, ok := other.dist[neighbor]; ok {
+				length := grow.dist[node] + 1 + d
+				if best == -1 || length < best {
+					best = length
+
+					// The meeting node must be seen by both sides, so it is
+					// added to this one as well
+					if _, seen := grow.prev[neighbor]; !seen {
+						grow.prev[neighbor] = node
+						grow.dist[neighbor] = grow.dist[node] + 1
+					}
+					meet = neighbor
+				}
+				continue
+			}
+
+			if _, seen := grow.prev[neighbor]; seen {
+				continue
+			}
+
+			grow.prev[neighbor] = node
+			grow.dist[neighbor] = grow.dist[node] + 1
+			next = append(next, neighbor)
+		}
+	}
+	grow.queue = next
+
+	return meet, best != -1
+}
@@ -0,0 +1,169 @@
+package graph
+
+import (
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestGraph_FindShortestPathBidirectional(t *testing.T) {
+	type args struct {
+		a int
+		b int
+	}
+	tests := []struct {
+		name    string
+		graph   IntGraph
+		args    args
+		want    []int
+		wantErr bool
+	}{
+		{
+			name: "Should find shortest path",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {4, 6, 8},
+					1: {2, 5, 8},
+					2: {1, 3},
+					3: {2, 7},
+					4: {0, 5},
+					5: {1, 4},
+					6: {0, 7},
+					7: {3, 6},
+					8: {0, 1},
+				},
+			},
+			args: args{a: 0, b: 3},
+			want: []int{0, 6, 7, 3},
+		},
+		{
+			name: "Should find a path with an odd number of edges",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1},
+					1: {0, 2},
+					2: {1, 3},
+					3: {2},
+				},
+			},
+			args: args{a: 3, b: 0},
+			want: []int{3, 2, 1, 0},
+		},
+		{
+			name: "Should take the shorter way around a cycle",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1, 2},
+					1: {0, 3},
+					2: {0, 4},
+					3: {1, 6},
+					4: {2, 5},
+					5: {4, 6},
+					6: {3, 5},
+				},
+			},
+			args: args{a: 0, b: 5},
+			want: []int{0, 2, 4, 5},
+		},
+		{
+			name: "Should return error for a path from a node to itself like FindShortestPath",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1, 2},
+					1: {0, 2},
+					2: {0, 1},
+				},
+			},
+			args:    args{a: 1, b: 1},
+			wantErr: true,
+		},
+		{
+			name: "Should return error if starting node does not exist",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1},
+					1: {0},
+				},
+			},
+			args:    args{a: 9, b: 1},
+			wantErr: true,
+		},
+		{
+			name: "Should return error if node b does not exist",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1},
+					1: {0},
+				},
+			},
+			args:    args{a: 0, b: 9},
+			wantErr: true,
+		},
+		{
+			name: "Should return error if a path does not exist",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1},
+					1: {0, 2},
+					2: {1},
+					3: {4},
+					4: {3},
+				},
+			},
+			args:    args{a: 0, b: 4},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.graph.FindShortestPathBidirectional(tt.args.a, tt.args.b)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Graph.FindShortestPathBidirectional() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Graph.FindShortestPathBidirectional() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGraph_FindShortestPathBidirectionalMatchesBFS(t *testing.T) {
+	r := rand.New(rand.NewSource(16))
+
+	for i := 0; i < 50; i++ {
+		g, _ := New(map[int][]int{})
+		for node := 0; node < 40; node++ {
+			_ = g.AddNode(node, nil)
+		}
+		for edge := 0; edge < 50; edge++ {
+			_ = g.AddEdge(r.Intn(40), r.Intn(40))
+		}
+
+		for query := 0; query < 20; query++ {
+			a, b := r.Intn(40), r.Intn(40)
+
+			want, wantErr := g.FindShortestPath(a, b)
+			got, err := g.FindShortestPathBidirectional(a, b)
+			if (err != nil) != (wantErr != nil) {
+				t.Fatalf("Graph.FindShortestPathBidirectional(%v, %v) error = %v, want %v", a, b, err, wantErr)
+			}
+			if len(got) != len(want) {
+				t.Fatalf("Graph.FindShortestPathBidirectional(%v, %v) = %v, want as long as %v", a, b, got, want)
+			}
+			if err != nil {
+				continue
+			}
+
+			if got[0] != a || got[len(got)-1] != b {
+				t.Fatalf("Graph.FindShortestPathBidirectional(%v, %v) = %v, does not connect the nodes", a, b, got)
+			}
+			for j := 1; j < len(got); j++ {
+				if !slices.Contains(g.nodes[got[j-1]], got[j]) {
+					t.Fatalf("Graph.FindShortestPathBidirectional(%v, %v) = %v, %v and %v are not connected", a, b, got, got[j-1], got[j])
+				}
+			}
+		}
+	}
+}
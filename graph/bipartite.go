@@ -0,0 +1,222 @@
+package graph
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Bipartition is the outcome of a bipartiteness check, see IsBipartite.
+type Bipartition[K comparable] struct {
+	// Left and Right hold the two sides of the graph, with every edge going
+	// from one side to the other. They are only set if the graph is
+	// bipartite.
+	Left  NodeSet[K]
+	Right NodeSet[K]
+	// OddCycle holds the nodes of a cycle with an odd number of nodes in the
+	// order they are connected, the last node being connected back to the
+	// first one. It proves the graph is not bipartite, and is only set if so.
+	OddCycle []K
+}
+
+// IsBipartite reports whether the nodes of the graph can be split into two
+// sides so that every edge connects nodes of different sides, returning the
+// sides if so and an odd cycle otherwise. The smallest node of every connected
+// component, as ordered when encoding the graph, is put on the left side. A
+// self-loop is an odd cycle of a single node.
+func (g *Graph[K]) IsBipartite() (Bipartition[K], bool) {
+	right := make(map[K]bool, len(g.nodes))
+	parent := make(map[K]K, len(g.nodes))
+
+	for _, root := range g.sortedNodes() {
+		if _, seen := right[root]; seen {
+			continue
+		}
+
+		// Color the component breadth first, so the two ends of a conflicting
+		// edge are at the same depth of the search tree
+		right[root] = false
+		parent[root] = root
+		queue := []K{root}
+		for len(queue) > 0 {
+			node := queue[0]
+			queue = queue[1:]
+
+			for _, neighbor := range g.nodes[node] {
+				side, seen := right[neighbor]
+				if !seen {
+					right[neighbor] = !right[node]
+					parent[neighbor] = node
+					queue = append(queue, neighbor)
+					continue
+				}
+
+				if side == right[node] {
+					return Bipartition[K]{OddCycle: oddCycle(parent, node, neighbor)}, false
+				}
+			}
+		}
+	}
+
+	b := Bipartition[K]{
+		Left:  make(NodeSet[K]),
+		Right: make(NodeSet[K]),
+	}
+	for node, isRight := range right {
+		if isRight {
+			b.Right[node] = struct{}{}
+		} else {
+			b.Left[node] = struct{}{}
+		}
+	}
+
+	return b, true
+}
+
+// oddCycle returns the cycle closed by the edge between node `a` and node `b`,
+// two nodes at the same depth of a breadth-first search tree given by
+// `parent`, going through their deepest common ancestor.
+func oddCycle[K comparable](parent map[K]K, a, b K) []K {
+	if a == b {
+		return []K{a}
+	}
+
+	fromA, fromB := []K{a}, []K{b}
+	for fromA[len(fromA)-1] != fromB[len(fromB)-1] {
+		fromA = append(fromA, parent[fromA[len(fromA)-1]])
+		fromB = append(fromB, parent[fromB[len(fromB)-1]])
+	}
+
+	slices.Reverse(fromA)
+
+	return append(fromA, fromB[:len(fromB)-1]...)
+}
+
+// MaximumMatching returns the largest set of edges of a bipartite graph in
+// which no two edges share a node, using the Hopcroft–Karp algorithm in
+// O(E·√V) time. Each edge goes from a node of the left side to a node of the
+// right side as given by IsBipartite, and edges are ordered by their left
+// node. Returns error if the graph is not bipartite.
+func (g *Graph[K]) MaximumMatching() ([]Edge[K], error) {
+	b, ok := g.IsBipartite()
+	if !ok {
+		return nil, fmt.Errorf("graph is not bipartite, nodes %v form an odd cycle", b.OddCycle)
+	}
+
+	left := make([]K, 0, len(b.Left))
+	for node := range b.Left {
+		left = append(left, node)
+	}
+	slices.SortFunc(left, compareNodes)
+
+	right := make([]K, 0, len(b.Right))
+	rightIndex := make(map[K]int, len(b.Right))
+	for node := range b.Right {
+		rightIndex[node] = len(right)
+		right = append(right, node)
+	}
+
+	adj := make([][]int, len(left))
+	for i, node := range left {
+		for _, neighbor := range g.nodes[node] {
+			adj[i] = append(adj[i], rightIndex[neighbor])
+		}
+	}
+
+	const unmatched = -1
+	matchLeft := make([]int, len(left))
+	matchRight := make([]int, len(right))
+	for i := range matchLeft {
+		matchLeft[i] = unmatched
+	}
+	for i := range matchRight {
+		matchRight[i] = unmatched
+	}
+
+	// dist holds the layer of every left node in the current phase, or -1 if
+	// it is not part of any shortest augmenting path, and freeLayer the layer
+	// of the left nodes next to the unmatched right nodes the shortest
+	// augmenting paths end at
+	dist := make([]int, len(left))
+	freeLayer := -1
+
+	// layer splits the left nodes into layers of alternating paths starting
+	// at unmatched left nodes, stopping at the first layer that reaches an
+	// unmatched right node, and reports whether any layer did
+	layer := func() bool {
+		var queue []int
+		for i := range left {
+			dist[i] = -1
+			if matchLeft[i] == unmatched {
+				dist[i] = 0
+				queue = append(queue, i)
+			}
+		}
+
+		freeLayer = -1
+		for len(queue) > 0 {
+			node := queue[0]
+			queue = queue[1:]
+
+			// Deeper layers only lead to longer augmenting paths
+			if freeLayer != -1 && dist[node] > freeLayer {
+				break
+			}
+
+			for _, neighbor := range adj[node] {
+				mate := matchRight[neighbor]
+				if mate == unmatched {
+					freeLayer = dist[node]
+				} else if dist[mate] == -1 {
+					dist[mate] = dist[node] + 1
+					queue = append(queue, mate)
+				}
+			}
+		}
+
+		return freeLayer != -1
+	}
+
+	// augment looks for a shortest augmenting path from the left node going
+	// one layer deeper at every step, and flips the matching along it if found
+	var augment func(node int) bool
+	augment = func(node int) bool {
+		for _, neighbor := range adj[node] {
+			mate := matchRight[neighbor]
+
+			var found bool
+			if mate == unmatched {
+				found = dist[node] == freeLayer
+			} else {
+				found = dist[mate] == dist[node]+1 && dist[mate] <= freeLayer && augment(mate)
+			}
+
+			if found {
+				matchLeft[node] = neighbor
+				matchRight[neighbor] = node
+				return true
+			}
+		}
+
+		// No augmenting path goes through this node in the current phase
+		dist[node] = -1
+
+		return false
+	}
+
+	for layer() {
+		for i := range left {
+			if matchLeft[i] == unmatched {
+				augment(i)
+			}
+		}
+	}
+
+	var matching []Edge[K]
+	for i, node := range left {
+		if matchLeft[i] != unmatched {
+			matching = append(matching, Edge[K]{A: node, B: right[matchLeft[i]]})
+		}
+	}
+
+	return matching, nil
+}
@@ -0,0 +1,229 @@
+package graph
+
+import (
+	"maps"
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestGraph_IsBipartite(t *testing.T) {
+	tests := []struct {
+		name          string
+		graph         IntGraph
+		wantLeft      []int
+		wantRight     []int
+		wantCycleSize int
+		want          bool
+	}{
+		{
+			name: "Should split an even cycle into two sides",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1, 3},
+					1: {0, 2},
+					2: {1, 3},
+					3: {2, 0},
+				},
+			},
+			wantLeft:  []int{0, 2},
+			wantRight: []int{1, 3},
+			want:      true,
+		},
+		{
+			name: "Should split every connected component",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1},
+					1: {0, 2},
+					2: {1},
+					3: {4},
+					4: {3},
+					5: {},
+				},
+			},
+			wantLeft:  []int{0, 2, 3, 5},
+			wantRight: []int{1, 4},
+			want:      true,
+		},
+		{
+			name: "Should return an odd cycle as a witness",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1, 4},
+					1: {0, 2},
+					2: {1, 3, 5},
+					3: {2, 4},
+					4: {3, 0},
+					5: {2},
+				},
+			},
+			wantCycleSize: 5,
+			want:          false,
+		},
+		{
+			name: "Should treat a self-loop as an odd cycle",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1},
+					1: {0, 1, 1},
+				},
+				selfLoops: true,
+			},
+			wantCycleSize: 1,
+			want:          false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.graph.IsBipartite()
+			if ok != tt.want {
+				t.Errorf("Graph.IsBipartite() = %v, want %v", ok, tt.want)
+				return
+			}
+
+			if !ok {
+				if len(got.OddCycle) != tt.wantCycleSize {
+					t.Errorf("Graph.IsBipartite() odd cycle = %v, want %v nodes", got.OddCycle, tt.wantCycleSize)
+				}
+				if len(got.OddCycle) > 1 && !isGraphCycle(&tt.graph, got.OddCycle) {
+					t.Errorf("Graph.IsBipartite() odd cycle = %v, which is not a cycle of the graph", got.OddCycle)
+				}
+				return
+			}
+
+			left := slices.Sorted(maps.Keys(got.Left))
+			right := slices.Sorted(maps.Keys(got.Right))
+			if !reflect.DeepEqual(left, tt.wantLeft) || !reflect.DeepEqual(right, tt.wantRight) {
+				t.Errorf("Graph.IsBipartite() = %v and %v, want %v and %v", left, right, tt.wantLeft, tt.wantRight)
+			}
+		})
+	}
+}
+
+func TestGraph_MaximumMatching(t *testing.T) {
+	tests := []struct {
+		name    string
+		graph   IntGraph
+		want    []Edge[int]
+		wantErr bool
+	}{
+		{
+			name: "Should find a perfect matching that needs augmenting paths",
+			graph: IntGraph{
+				// Workers 0, 2 and 4 against tasks 1, 3 and 5, where greedily
+				// giving task 1 to worker 0 has to be undone
+				nodes: map[int][]int{
+					0: {1, 3},
+					1: {0, 2},
+					2: {1},
+					3: {0, 4},
+					4: {3, 5},
+					5: {4},
+				},
+			},
+			want: []Edge[int]{{A: 0, B: 3}, {A: 2, B: 1}, {A: 4, B: 5}},
+		},
+		{
+			name: "Should leave nodes unmatched when there are not enough partners",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1, 2, 3},
+					1: {0},
+					2: {0},
+					3: {0},
+					4: {},
+				},
+			},
+			want: []Edge[int]{{A: 0, B: 1}},
+		},
+		{
+			name: "Should return error if the graph is not bipartite",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1, 2},
+					1: {0, 2},
+					2: {0, 1},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.graph.MaximumMatching()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Graph.MaximumMatching() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Graph.MaximumMatching() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGraph_MaximumMatchingSize(t *testing.T) {
+	// Every node of the grid has a partner, except one corner when the number
+	// of nodes is odd
+	for _, size := range [][2]int{{1, 1}, {2, 3}, {5, 5}, {8, 7}} {
+		g := gridGraph(size[0], size[1])
+
+		got, err := g.MaximumMatching()
+		if err != nil {
+			t.Fatalf("Graph.MaximumMatching() error = %v", err)
+		}
+		if want := size[0] * size[1] / 2; len(got) != want {
+			t.Errorf("Graph.MaximumMatching() of a %vx%v grid has %v edges, want %v", size[0], size[1], len(got), want)
+		}
+
+		matched := make(map[int]bool)
+		for _, edge := range got {
+			if matched[edge.A] || matched[edge.B] || !slices.Contains(g.nodes[edge.A], edge.B) {
+				t.Fatalf("Graph.MaximumMatching() = %v, which is not a matching", got)
+			}
+			matched[edge.A], matched[edge.B] = true, true
+		}
+	}
+}
+
+func TestGraph_MaximumMatchingMatchesMaxFlow(t *testing.T) {
+	r := rand.New(rand.NewSource(19))
+
+	for i := 0; i < 50; i++ {
+		// Workers 0 to 9 against tasks 10 to 19
+		g, _ := New(map[int][]int{})
+		n, _ := NewFlowNetwork(map[int]map[int]float64{-1: {}, -2: {}})
+		for node := range 20 {
+			_ = g.AddNode(node, nil)
+			_ = n.AddNode(node)
+			if node < 10 {
+				_ = n.AddArc(-1, node, 1)
+			} else {
+				_ = n.AddArc(node, -2, 1)
+			}
+		}
+		for worker := range 10 {
+			for task := 10; task < 20; task++ {
+				if r.Float64() < 0.2 {
+					_ = g.AddEdge(worker, task)
+					_ = n.AddArc(worker, task, 1)
+				}
+			}
+		}
+
+		matching, err := g.MaximumMatching()
+		if err != nil {
+			t.Fatalf("Graph.MaximumMatching() error = %v", err)
+		}
+		flow, err := n.Dinic(-1, -2)
+		if err != nil {
+			t.Fatalf("FlowNetwork.Dinic() error = %v", err)
+		}
+
+		if float64(len(matching)) != flow.Value {
+			t.Fatalf("Graph.MaximumMatching() = %v, want %v edges", matching, flow.Value)
+		}
+	}
+}
@@ -0,0 +1,317 @@
+package graph
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// NodeSet is a set of nodes.
+type NodeSet[K comparable] map[K]struct{}
+
+// Contains checks whether the node is in the set.
+func (s NodeSet[K]) Contains(node K) bool {
+	_, ok := s[node]
+	return ok
+}
+
+// ConnectedComponents returns the connected components of the graph, each one
+// being the set of nodes reachable from each other.
+func (g *Graph[K]) ConnectedComponents() []NodeSet[K] {
+	index := g.componentIndex()
+
+	components := make([]NodeSet[K], 0, len(index.members))
+	for _, members := range index.members {
+		components = append(components, maps.Clone(members))
+	}
+
+	return components
+}
+
+// ComponentOf returns the label of the connected component the node belongs
+// to. Two nodes share a label if and only if they are reachable from each
+// other. Labels are nodes of the component and may change after the graph is
+// modified.
+func (g *Graph[K]) ComponentOf(nodeID K) (K, error) {
+	if _, ok := g.nodes[nodeID]; !ok {
+		var zero K
+		return zero, fmt.Errorf("node %v does not exist", nodeID)
+	}
+
+	index := g.componentIndex()
+	return index.rep[index.label[nodeID]], nil
+}
+
+// Connected checks whether node `a` and node `b` are in the same connected
+// component. Unlike PathExists it does not search the graph, the components
+// are looked up in an index kept up to date as the graph is modified.
+func (g *Graph[K]) Connected(a, b K) bool {
+	if _, ok := g.nodes[a]; !ok {
+		return false
+	}
+	if _, ok := g.nodes[b]; !ok {
+		return false
+	}
+
+	index := g.componentIndex()
+	return index.label[a] == index.label[b]
+}
+
+// componentIndex returns the index of the graph's components. The index is
+// only ever written by the methods modifying the graph, so looking nodes up in
+// it is safe alongside other queries. A graph without an index, one not
+// created by New or Clone, gets a fresh one that is not kept, so its component
+// labels may differ between calls.
+func (g *Graph[K]) componentIndex() *componentIndex[K] {
+	if g.components != nil {
+		return g.components
+	}
+
+	return buildComponentIndex(g.nodes)
+}
+
+// rebuildComponents replaces the component index of the graph with one built
+// from scratch.
+func (g *Graph[K]) rebuildComponents() {
+	g.components = buildComponentIndex(g.nodes)
+}
+
+// splitComponent updates the component index after the last edge between node
+// `a` and node `b` is removed, splitting their component if they no longer
+// reach each other. Both nodes are searched from in turns, so the search stops
+// as soon as the searches meet, or one of them runs out of nodes, having gone
+// through the smaller part of the component only.
+func (g *Graph[K]) splitComponent(a, b K) {
+	if g.components == nil || a == b || g.components.label[a] != g.components.label[b] {
+		return
+	}
+
+	seeds := [2]K{a, b}
+	seen := [2]NodeSet[K]{{a: {}}, {b: {}}}
+	queues := [2][]K{{a}, {b}}
+	for {
+		for side := range 2 {
+			if len(queues[side]) == 0 {
+				g.components.split(seen[side], seeds[side], seeds[1-side])
+				return
+			}
+
+			node := queues[side][0]
+			queues[side] = queues[side][1:]
+
+			for _, neighbor := range g.nodes[node] {
+				// Reached a node the other side reached, they are still
+				// connected
+				if seen[1-side].Contains(neighbor) {
+					return
+				}
+				if seen[side].Contains(neighbor) {
+					continue
+				}
+
+				seen[side][neighbor] = struct{}{}
+				queues[side] = append(queues[side], neighbor)
+			}
+		}
+	}
+}
+
+// removeFromComponent updates the component index after the node is removed
+// along with its edges to `neighbors`, splitting the component if the
+// neighbors no longer reach each other.
+func (g *Graph[K]) removeFromComponent(nodeID K, neighbors []K) {
+	if g.components == nil {
+		return
+	}
+
+	g.components.remove(nodeID)
+
+	neighbors = slices.DeleteFunc(slices.Clone(neighbors), func(neighbor K) bool {
+		return neighbor == nodeID
+	})
+	slices.SortFunc(neighbors, compareNodes)
+	neighbors = slices.Compact(neighbors)
+
+	for i, a := range neighbors {
+		for _, b := range neighbors[i+1:] {
+			g.splitComponent(a, b)
+		}
+	}
+}
+
+func buildComponentIndex[K comparable](nodes map[K][]K) *componentIndex[K] {
+	index := newComponentIndex[K](len(nodes))
+	for node, neighbors := range nodes {
+		index.add(node)
+		for _, neighbor := range neighbors {
+			index.add(neighbor)
+			index.union(node, neighbor)
+		}
+	}
+
+	return index
+}
+
+// componentIndex labels every node with the id of its component. Merging two
+// components relabels the smaller one, and a component is split by relabelling
+// the part that is split off, so that looking a node up never writes to the
+// index.
+type componentIndex[K comparable] struct {
+	label   map[K]int
+	members map[int]NodeSet[K]
+	// rep is a node of each component, standing for it in ComponentOf.
+	rep    map[int]K
+	nextID int
+}
+
+func newComponentIndex[K comparable](capacity int) *componentIndex[K] {
+	return &componentIndex[K]{
+		label:   make(map[K]int, capacity),
+		members: make(map[int]NodeSet[K], capacity),
+		rep:     make(map[int]K, capacity),
+	}
+}
+
+// add puts the node into a component of its own, unless it is known already.
+func (c *componentIndex[K]) add(node K) {
+	if _, ok := c.label[node]; ok {
+		return
+	}
+
+	id := c.nextID
+	c.nextID++
+
+	c.label[node] = id
+	c.members[id] = NodeSet[K]{node: {}}
+	c.rep[id] = node
+}
+
+// union merges the components of node `a` and node `b`.
+func (c *componentIndex[K]) union(a, b K) {
+	idA, idB := c.label[a], c.label[b]
+	if idA == idB {
+		return
+	}
+
+	if len(c.members[idA]) < len(c.members[idB]) {
+		idA, idB = idB, idA
+	}
+
+	for node := range c.members[idB] {
+		c.label[node] = idA
+		c.members[idA][node] = struct{}{}
+	}
+	delete(c.members, idB)
+	delete(c.rep, idB)
+}
+
+// split moves the nodes of `part` out of their component into a new one, which
+// `seed`, a node of the part, stands for. `other` is a node of the rest of the
+// component, which stands for it if its previous representative was moved.
+func (c *componentIndex[K]) split(part NodeSet[K], seed, other K) {
+	old := c.label[seed]
+
+	id := c.nextID
+	c.nextID++
+
+	for node := range part {
+		c.label[node] = id
+		delete(c.members[old], node)
+	}
+	c.members[id] = part
+	c.rep[id] = seed
+
+	if part.Contains(c.rep[old]) {
+		c.rep[old] = other
+	}
+}
+
+// remove drops the node from its component.
+func (c *componentIndex[K]) remove(node K) {
+	id, ok := c.label[node]
+	if !ok {
+		return
+	}
+
+	delete(c.label, node)
+	delete(c.members[id], node)
+
+	if len(c.members[id]) == 0 {
+		delete(c.members, id)
+		delete(c.rep, id)
+		return
+	}
+	if c.rep[id] == node {
+		for member := range c.members[id] {
+			c.rep[id] = member
+			break
+		}
+	}
+}
+
+// clone returns a deep copy of the index.
+func (c *componentIndex[K]) clone() *componentIndex[K] {
+	clone := &componentIndex[K]{
+		label:   maps.Clone(c.label),
+		members: make(map[int]NodeSet[K], len(c.members)),
+		rep:     maps.Clone(c.rep),
+		nextID:  c.nextID,
+	}
+	for id, members := range c.members {
+		clone.members[id] = maps.Clone(members)
+	}
+
+	return clone
+}
+
+// unionFind is a disjoint-set forest with union by size, which keeps its trees
+// logarithmically deep, so finding a root does not need to compress paths.
+type unionFind[K comparable] struct {
+	parent map[K]K
+	size   map[K]int
+}
+
+func newUnionFind[K comparable](capacity int) *unionFind[K] {
+	return &unionFind[K]{
+		parent: make(map[K]K, capacity),
+		size:   make(map[K]int, capacity),
+	}
+}
+
+// add puts the node into a set of its own, unless it is known already.
+func (u *unionFind[K]) add(node K) {
+	if _, ok := u.parent[node]; ok {
+		return
+	}
+
+	u.parent[node] = node
+	u.size[node] = 1
+}
+
+// find returns the root of the set the node belongs to.
+func (u *unionFind[K]) find(node K) K {
+	for u.parent[node] != node {
+		node = u.parent[node]
+	}
+
+	return node
+}
+
+// union merges the sets of node `a` and node `b`, reporting whether they were
+// separate sets before.
+func (u *unionFind[K]) union(a, b K) bool {
+	rootA, rootB := u.find(a), u.find(b)
+	if rootA == rootB {
+		return false
+	}
+
+	if u.size[rootA] < u.size[rootB] {
+		rootA, rootB = rootB, rootA
+	}
+
+	u.parent[rootB] = rootA
+	u.size[rootA] += u.size[rootB]
+	delete(u.size, rootB)
+
+	return true
+}
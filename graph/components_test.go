@@ -0,0 +1,193 @@
+package graph
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestGraph_ConnectedComponents(t *testing.T) {
+	tests := []struct {
+		name  string
+		graph IntGraph
+		want  [][]int
+	}{
+		{
+			name: "Should return every connected component",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0:  {4, 6, 8},
+					1:  {2, 5, 8},
+					2:  {1, 3},
+					3:  {2, 7},
+					4:  {0, 5},
+					5:  {1, 4},
+					6:  {0, 7},
+					7:  {3, 6},
+					8:  {0, 1},
+					9:  {10},
+					10: {9},
+					11: {},
+				},
+			},
+			want: [][]int{
+				{0, 1, 2, 3, 4, 5, 6, 7, 8},
+				{9, 10},
+				{11},
+			},
+		},
+		{
+			name: "Should return no components for an empty graph",
+			graph: IntGraph{
+				nodes: map[int][]int{},
+			},
+			want: [][]int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := sortedComponents(tt.graph.ConnectedComponents())
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Graph.ConnectedComponents() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGraph_ComponentOf(t *testing.T) {
+	// Labels are only stable between calls on a graph indexed by New
+	g, err := New(map[int][]int{
+		0: {1},
+		1: {0},
+		2: {3},
+		3: {2},
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	label0, err := g.ComponentOf(0)
+	if err != nil {
+		t.Fatalf("Graph.ComponentOf() error = %v", err)
+	}
+	label1, _ := g.ComponentOf(1)
+	label2, _ := g.ComponentOf(2)
+
+	if label0 != label1 {
+		t.Errorf("Graph.ComponentOf() labels of connected nodes differ: %v and %v", label0, label1)
+	}
+	if label0 == label2 {
+		t.Errorf("Graph.ComponentOf() labels of disconnected nodes are both %v", label0)
+	}
+
+	if _, err := g.ComponentOf(9); err == nil {
+		t.Errorf("Graph.ComponentOf() expected error for a non-existent node")
+	}
+}
+
+func TestGraph_Connected(t *testing.T) {
+	// New builds the index, the mutations below must keep it up to date
+	g, err := New(map[int][]int{
+		0: {1},
+		1: {0},
+		2: {},
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	if g.Connected(0, 2) {
+		t.Fatalf("Graph.Connected(0, 2) = true before adding an edge")
+	}
+
+	if err := g.AddNode(3, []int{2}); err != nil {
+		t.Fatalf("Graph.AddNode() error = %v", err)
+	}
+	if !g.Connected(2, 3) {
+		t.Errorf("Graph.Connected(2, 3) = false after adding a node")
+	}
+
+	if err := g.AddEdge(1, 3); err != nil {
+		t.Fatalf("Graph.AddEdge() error = %v", err)
+	}
+	if !g.Connected(0, 2) {
+		t.Errorf("Graph.Connected(0, 2) = false after adding an edge")
+	}
+
+	if err := g.RemoveEdge(1, 3); err != nil {
+		t.Fatalf("Graph.RemoveEdge() error = %v", err)
+	}
+	if g.Connected(0, 2) {
+		t.Errorf("Graph.Connected(0, 2) = true after removing the edge")
+	}
+
+	if err := g.RemoveNode(3); err != nil {
+		t.Fatalf("Graph.RemoveNode() error = %v", err)
+	}
+	if g.Connected(2, 3) {
+		t.Errorf("Graph.Connected(2, 3) = true after removing the node")
+	}
+}
+
+// sortedComponents turns components into sorted slices ordered by their
+// smallest node, so they can be compared.
+func sortedComponents(components []NodeSet[int]) [][]int {
+	result := make([][]int, 0, len(components))
+	for _, component := range components {
+		nodes := make([]int, 0, len(component))
+		for node := range component {
+			nodes = append(nodes, node)
+		}
+		slices.Sort(nodes)
+
+		result = append(result, nodes)
+	}
+
+	slices.SortFunc(result, slices.Compare)
+
+	return result
+}
+
+func TestGraph_Connected_AfterRemovingNode(t *testing.T) {
+	// Removing the center of a star splits it into a component per leaf
+	g, err := NewStar(5)
+	if err != nil {
+		t.Fatalf("NewStar() error = %v", err)
+	}
+	if err := g.RemoveNode(0); err != nil {
+		t.Fatalf("Graph.RemoveNode() error = %v", err)
+	}
+
+	if got := len(g.ConnectedComponents()); got != 4 {
+		t.Errorf("Graph.ConnectedComponents() = %d components, want 4", got)
+	}
+	if g.Connected(1, 2) {
+		t.Errorf("Graph.Connected(1, 2) = true after removing the center")
+	}
+	label, _ := g.ComponentOf(3)
+	if label != 3 {
+		t.Errorf("Graph.ComponentOf(3) = %v, want 3", label)
+	}
+}
+
+func BenchmarkGraph_RemoveEdge(b *testing.B) {
+	const width = 1000
+
+	g, err := NewGrid(width, width)
+	if err != nil {
+		b.Fatalf("NewGrid() error = %v", err)
+	}
+
+	b.ResetTimer()
+	for i := range b.N {
+		// Remove and restore an edge in the middle of the grid, which keeps
+		// it connected
+		node := width*width/2 + i%width
+		if err := g.RemoveEdge(node, node+width); err != nil {
+			b.Fatalf("Graph.RemoveEdge() error = %v", err)
+		}
+		if err := g.AddEdge(node, node+width); err != nil {
+			b.Fatalf("Graph.AddEdge() error = %v", err)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package graph
+
+import "sync"
+
+// ConcurrentGraph wraps a Graph so it can be shared across goroutines. The
+// wrapper exposes the mutations, which hold the write lock and run one at a
+// time, while queries run inside Read, holding the read lock so that any number
+// of them can run at the same time, or on a Snapshot.
+type ConcurrentGraph[K comparable] struct {
+	mu    sync.RWMutex
+	graph *Graph[K]
+}
+
+// NewConcurrent wraps the graph for concurrent use. The graph must not be used
+// directly afterwards, only through the returned wrapper.
+func NewConcurrent[K comparable](g *Graph[K]) *ConcurrentGraph[K] {
+	return &ConcurrentGraph[K]{
+		graph: g,
+	}
+}
+
+// Read runs `fn` with the graph under the read lock, for queries. The graph
+// must not be modified or retained by `fn`, and `fn` must not call any method
+// of the wrapper, as the read lock can't be taken again while a writer is
+// waiting, which would deadlock. The same goes for callbacks `fn` passes on,
+// such as the comparison of FindShortestPathFunc or an A* heuristic.
+func (c *ConcurrentGraph[K]) Read(fn func(g *Graph[K])) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	fn(c.graph)
+}
+
+// Write runs `fn` with the graph under the write lock, for several mutations
+// that have to be seen by readers at once. The graph must not be retained by
+// `fn`, and `fn` must not call any method of the wrapper, which would
+// deadlock.
+func (c *ConcurrentGraph[K]) Write(fn func(g *Graph[K]) error) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return fn(c.graph)
+}
+
+// Snapshot returns a deep copy of the graph as it is at the moment, which can
+// be used freely without holding any lock.
+func (c *ConcurrentGraph[K]) Snapshot() *Graph[K] {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.graph.Clone()
+}
+
+// AddNode is the concurrency-safe version of Graph.AddNode.
+func (c *ConcurrentGraph[K]) AddNode(nodeID K, connections []K) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.graph.AddNode(nodeID, connections)
+}
+
+// RemoveNode is the concurrency-safe version of Graph.RemoveNode.
+func (c *ConcurrentGraph[K]) RemoveNode(nodeID K) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.graph.RemoveNode(nodeID)
+}
+
+// AddEdge is the concurrency-safe version of Graph.AddEdge.
+func (c *ConcurrentGraph[K]) AddEdge(a, b K) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.graph.AddEdge(a, b)
+}
+
+// AddWeightedEdge is the concurrency-safe version of Graph.AddWeightedEdge.
+func (c *ConcurrentGraph[K]) AddWeightedEdge(a, b K, weight float64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.graph.AddWeightedEdge(a, b, weight)
+}
+
+// AddEdgeWithID is the concurrency-safe version of Graph.AddEdgeWithID.
+func (c *ConcurrentGraph[K]) AddEdgeWithID(a, b K) (EdgeID, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.graph.AddEdgeWithID(a, b)
+}
+
+// RemoveEdgeByID is the concurrency-safe version of Graph.RemoveEdgeByID.
+func (c *ConcurrentGraph[K]) RemoveEdgeByID(id EdgeID) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.graph.RemoveEdgeByID(id)
+}
+
+// RemoveEdge is the concurrency-safe version of Graph.RemoveEdge.
+func (c *ConcurrentGraph[K]) RemoveEdge(a, b K) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.graph.RemoveEdge(a, b)
+}
+
+// SetNodeAttr is the concurrency-safe version of Graph.SetNodeAttr. Attributes
+// are read with NodeAttr inside Read.
+func (c *ConcurrentGraph[K]) SetNodeAttr(nodeID K, key string, value any) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.graph.SetNodeAttr(nodeID, key, value)
+}
+
+// DeleteNodeAttr is the concurrency-safe version of Graph.DeleteNodeAttr.
+func (c *ConcurrentGraph[K]) DeleteNodeAttr(nodeID K, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.graph.DeleteNodeAttr(nodeID, key)
+}
+
+// SetEdgeAttr is the concurrency-safe version of Graph.SetEdgeAttr. Attributes
+// are read with EdgeAttr inside Read.
+func (c *ConcurrentGraph[K]) SetEdgeAttr(a, b K, key string, value any) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.graph.SetEdgeAttr(a, b, key, value)
+}
+
+// DeleteEdgeAttr is the concurrency-safe version of Graph.DeleteEdgeAttr.
+func (c *ConcurrentGraph[K]) DeleteEdgeAttr(a, b K, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.graph.DeleteEdgeAttr(a, b, key)
+}
@@ -0,0 +1,126 @@
+package graph
+
+import (
+	"io"
+	"sync"
+	"testing"
+)
+
+// The tests below are meant to be run with the race detector, go test -race,
+// which reports any access to the graph that is not guarded by the lock.
+
+func TestConcurrentGraph_ReadersAndWriters(t *testing.T) {
+	g, err := New(map[int][]int{
+		0: {4, 6, 8},
+		1: {2, 5, 8},
+		2: {1, 3},
+		3: {2, 7},
+		4: {0, 5},
+		5: {1, 4},
+		6: {0, 7},
+		7: {3, 6},
+		8: {0, 1},
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	c := NewConcurrent(g)
+
+	var wg sync.WaitGroup
+	for reader := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for i := range 100 {
+				c.Read(func(g *Graph[int]) {
+					// The path between the original nodes always exists, as
+					// writers never touch them
+					if !g.PathExists(0, 3) {
+						t.Errorf("Graph.PathExists(0, 3) = false in reader %d", reader)
+					}
+
+					_, _ = g.FindShortestPath(0, i%8+1)
+					_, _, _ = g.FindShortestWeightedPath(reader, i%9)
+					_ = g.Connected(0, i)
+					_, _ = g.ComponentOf(i % 9)
+					_ = g.ConnectedComponents()
+					_ = g.CycleExists()
+					_ = g.WriteEdgeList(io.Discard)
+				})
+			}
+		}()
+	}
+
+	for writer := range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for i := range 50 {
+				node := 100 + writer*1000 + i
+				if err := c.AddNode(node, []int{8}); err != nil {
+					t.Errorf("ConcurrentGraph.AddNode() error = %v", err)
+				}
+				if err := c.AddWeightedEdge(node, 5, 2); err != nil {
+					t.Errorf("ConcurrentGraph.AddWeightedEdge() error = %v", err)
+				}
+				if err := c.RemoveEdge(node, 8); err != nil {
+					t.Errorf("ConcurrentGraph.RemoveEdge() error = %v", err)
+				}
+				if err := c.RemoveNode(node); err != nil {
+					t.Errorf("ConcurrentGraph.RemoveNode() error = %v", err)
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	got, err := c.Snapshot().FindShortestPath(0, 3)
+	if err != nil || len(got) != 4 {
+		t.Errorf("Graph.FindShortestPath(0, 3) = %v, %v, want a path of 4 nodes", got, err)
+	}
+}
+
+func TestConcurrentGraph_Write(t *testing.T) {
+	g, err := New(map[int][]int{
+		0: {},
+		1: {},
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	c := NewConcurrent(g)
+
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for range 50 {
+				// Readers must never observe the edge half added
+				err := c.Write(func(g *Graph[int]) error {
+					if err := g.AddEdge(0, 1); err != nil {
+						return err
+					}
+					return g.RemoveEdge(0, 1)
+				})
+				if err != nil {
+					t.Errorf("ConcurrentGraph.Write() error = %v", err)
+				}
+
+				c.Read(func(g *Graph[int]) {
+					if len(g.nodes[0]) != 0 {
+						t.Errorf("ConcurrentGraph.Read() observed edges %v", g.nodes[0])
+					}
+				})
+			}
+		}()
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,251 @@
+package graph
+
+import "slices"
+
+// FindCycle returns the nodes of one cycle in the graph in the order they are
+// connected, the last node being connected back to the first one. Parallel
+// edges form a cycle of their two nodes and a self-loop a cycle of its single
+// node, see CycleExists. It runs a single depth-first search, so it takes
+// linear time in the size of the graph. If the graph has no cycle, false is
+// returned.
+func (g *Graph[K]) FindCycle() ([]K, bool) {
+	const (
+		unvisited = iota
+		inProgress
+		done
+	)
+
+	state := make(map[K]int, len(g.nodes))
+	position := make(map[K]int)
+	var path []K
+
+	var visit func(node, parent K, hasParent bool) []K
+	visit = func(node, parent K, hasParent bool) []K {
+		state[node] = inProgress
+		position[node] = len(path)
+		path = append(path, node)
+
+		skippedParent := false
+		for _, neighbor := range g.nodes[node] {
+			// This is the edge we came from, any other edge to the parent
+			// is a parallel edge forming a cycle of its own
+			if hasParent && neighbor == parent && !skippedParent {
+				skippedParent = true
+				continue
+			}
+
+			switch state[neighbor] {
+			case inProgress:
+				// Came back to a node on the current path
+				return slices.Clone(path[position[neighbor]:])
+			case unvisited:
+				if cycle := visit(neighbor, node, true); cycle != nil {
+					return cycle
+				}
+			}
+		}
+
+		state[node] = done
+		path = path[:len(path)-1]
+
+		return nil
+	}
+
+	for node := range g.nodes {
+		if state[node] != unvisited {
+			continue
+		}
+
+		var noParent K
+		if cycle := visit(node, noParent, false); cycle != nil {
+			return cycle, true
+		}
+	}
+
+	return nil, false
+}
+
+// FindAllCycles returns every simple cycle in the graph using Johnson's
+// algorithm, each one listed once in the order its nodes are connected. A
+// self-loop is returned as a cycle of a single node, and parallel edges as a
+// cycle of their two nodes, listed once however many edges there are. The
+// number of cycles can grow exponentially with the size of the graph, so it is
+// meant for small graphs.
+func (g *Graph[K]) FindAllCycles() [][]K {
+	nodes := make([]K, 0, len(g.nodes))
+	for node := range g.nodes {
+		nodes = append(nodes, node)
+	}
+
+	index := make(map[K]int, len(nodes))
+	for i, node := range nodes {
+		index[node] = i
+	}
+
+	// Every undirected cycle is found once in each direction, as well as
+	// every edge being found as a cycle of two nodes going there and back,
+	// which is only a cycle if there is another edge to come back along
+	var cycles [][]K
+	for _, cycle := range elementaryCycles(nodes, g.neighborSet) {
+		if len(cycle) == 2 && g.Multiplicity(cycle[0], cycle[1]) < 2 {
+			continue
+		}
+		if len(cycle) > 2 && index[cycle[1]] > index[cycle[len(cycle)-1]] {
+			continue
+		}
+
+		cycles = append(cycles, cycle)
+	}
+
+	return cycles
+}
+
+// neighborSet returns the neighbors of a node with parallel edges collapsed.
+func (g *Graph[K]) neighborSet(node K) []K {
+	neighbors := make([]K, 0, len(g.nodes[node]))
+	for _, neighbor := range g.nodes[node] {
+		if !slices.Contains(neighbors, neighbor) {
+			neighbors = append(neighbors, neighbor)
+		}
+	}
+
+	return neighbors
+}
+
+// FindCycle returns the nodes of one directed cycle in the digraph in arc
+// order, the last node pointing back to the first one. It runs a single
+// depth-first search, so it takes linear time in the size of the digraph. If
+// the digraph has no cycle, false is returned.
+func (d *Digraph[K]) FindCycle() ([]K, bool) {
+	const (
+		unvisited = iota
+		inProgress
+		done
+	)
+
+	state := make(map[K]int, len(d.out))
+	position := make(map[K]int)
+	var path []K
+
+	var visit func(node K) []K
+	visit = func(node K) []K {
+		state[node] = inProgress
+		position[node] = len(path)
+		path = append(path, node)
+
+		for _, successor := range d.out[node] {
+			switch state[successor] {
+			case inProgress:
+				// Came back to a node on the current path
+				return slices.Clone(path[position[successor]:])
+			case unvisited:
+				if cycle := visit(successor); cycle != nil {
+					return cycle
+				}
+			}
+		}
+
+		state[node] = done
+		path = path[:len(path)-1]
+
+		return nil
+	}
+
+	for node := range d.out {
+		if state[node] != unvisited {
+			continue
+		}
+
+		if cycle := visit(node); cycle != nil {
+			return cycle, true
+		}
+	}
+
+	return nil, false
+}
+
+// FindAllCycles returns every elementary directed cycle in the digraph using
+// Johnson's algorithm, each one listed once in arc order. The number of cycles
+// can grow exponentially with the size of the digraph, so it is meant for
+// small digraphs.
+func (d *Digraph[K]) FindAllCycles() [][]K {
+	nodes := make([]K, 0, len(d.out))
+	for node := range d.out {
+		nodes = append(nodes, node)
+	}
+
+	return elementaryCycles(nodes, func(node K) []K {
+		return d.out[node]
+	})
+}
+
+// elementaryCycles implements Johnson's algorithm over the given nodes and
+// their successors. Cycles are searched from each node in turn, only going
+// through nodes that come after it, so every cycle is reported exactly once,
+// starting from its earliest node. Nodes that can't lead back to the start are
+// kept blocked until one of their successors gets unblocked, which keeps the
+// search from exploring the same dead end twice.
+func elementaryCycles[K comparable](nodes []K, successors func(node K) []K) [][]K {
+	index := make(map[K]int, len(nodes))
+	for i, node := range nodes {
+		index[node] = i
+	}
+
+	var cycles [][]K
+	for start, startNode := range nodes {
+		blocked := make(map[K]bool)
+		blockedBy := make(map[K][]K)
+		var stack []K
+
+		var unblock func(node K)
+		unblock = func(node K) {
+			blocked[node] = false
+			waiting := blockedBy[node]
+			delete(blockedBy, node)
+
+			for _, w := range waiting {
+				if blocked[w] {
+					unblock(w)
+				}
+			}
+		}
+
+		var circuit func(node K) bool
+		circuit = func(node K) bool {
+			found := false
+			stack = append(stack, node)
+			blocked[node] = true
+
+			for _, successor := range successors(node) {
+				if index[successor] < start {
+					continue
+				}
+
+				if successor == startNode {
+					cycles = append(cycles, slices.Clone(stack))
+					found = true
+				} else if !blocked[successor] && circuit(successor) {
+					found = true
+				}
+			}
+
+			if found {
+				unblock(node)
+			} else {
+				for _, successor := range successors(node) {
+					if index[successor] >= start && !slices.Contains(blockedBy[successor], node) {
+						blockedBy[successor] = append(blockedBy[successor], node)
+					}
+				}
+			}
+
+			stack = stack[:len(stack)-1]
+
+			return found
+		}
+
+		circuit(startNode)
+	}
+
+	return cycles
+}
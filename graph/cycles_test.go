@@ -0,0 +1,274 @@
+package graph
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestGraph_FindCycle(t *testing.T) {
+	tests := []struct {
+		name    string
+		graph   IntGraph
+		wantLen int
+		wantOk  bool
+	}{
+		{
+			name: "Should find a cycle",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1},
+					1: {0, 2, 3},
+					2: {1, 3},
+					3: {1, 2, 4},
+					4: {3},
+				},
+			},
+			wantLen: 3,
+			wantOk:  true,
+		},
+		{
+			name: "Should find a cycle formed by parallel edges",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1, 1},
+					1: {0, 0},
+				},
+			},
+			wantLen: 2,
+			wantOk:  true,
+		},
+		{
+			name: "Should find a self-loop",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1},
+					1: {0, 1, 1},
+				},
+			},
+			wantLen: 1,
+			wantOk:  true,
+		},
+		{
+			name: "Should not find a cycle in a forest",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1, 2},
+					1: {0},
+					2: {0},
+					3: {4},
+					4: {3},
+				},
+			},
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.graph.FindCycle()
+			if ok != tt.wantOk {
+				t.Fatalf("Graph.FindCycle() ok = %v, want %v", ok, tt.wantOk)
+			}
+			if len(got) != tt.wantLen {
+				t.Errorf("Graph.FindCycle() = %v, want a cycle of %d nodes", got, tt.wantLen)
+			}
+			if !isGraphCycle(&tt.graph, got) {
+				t.Errorf("Graph.FindCycle() = %v, which is not a cycle", got)
+			}
+		})
+	}
+}
+
+func TestGraph_FindAllCycles(t *testing.T) {
+	tests := []struct {
+		name  string
+		graph IntGraph
+		want  [][]int
+	}{
+		{
+			name: "Should find every simple cycle once",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1, 2, 3},
+					1: {0, 2},
+					2: {0, 1, 3},
+					3: {0, 2},
+				},
+			},
+			want: [][]int{
+				{0, 1, 2},
+				{0, 2, 3},
+				{0, 1, 2, 3},
+			},
+		},
+		{
+			name: "Should find self-loops and parallel edges",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1, 1, 1},
+					1: {0, 0, 0, 2},
+					2: {1, 2, 2},
+				},
+			},
+			want: [][]int{
+				{0, 1},
+				{2},
+			},
+		},
+		{
+			name: "Should find no cycles in a tree",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1, 2},
+					1: {0},
+					2: {0},
+				},
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.graph.FindAllCycles()
+			for _, cycle := range got {
+				if !isGraphCycle(&tt.graph, cycle) {
+					t.Errorf("Graph.FindAllCycles() returned %v, which is not a cycle", cycle)
+				}
+			}
+
+			if !reflect.DeepEqual(canonicalCycles(got, true), canonicalCycles(tt.want, true)) {
+				t.Errorf("Graph.FindAllCycles() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDigraph_FindCycle(t *testing.T) {
+	tests := []struct {
+		name   string
+		arcs   map[int][]int
+		want   []int
+		wantOk bool
+	}{
+		{
+			name: "Should find the directed cycle",
+			arcs: map[int][]int{
+				0: {1},
+				1: {2},
+				2: {3},
+				3: {1},
+			},
+			want:   []int{1, 2, 3},
+			wantOk: true,
+		},
+		{
+			name: "Should not find a cycle in a diamond",
+			arcs: map[int][]int{
+				0: {1, 2},
+				1: {3},
+				2: {3},
+				3: {},
+			},
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := NewDigraph(tt.arcs)
+			if err != nil {
+				t.Fatalf("NewDigraph() error = %v", err)
+			}
+
+			got, ok := d.FindCycle()
+			if ok != tt.wantOk {
+				t.Fatalf("Digraph.FindCycle() ok = %v, want %v", ok, tt.wantOk)
+			}
+			if !reflect.DeepEqual(canonicalCycles([][]int{got}, false), canonicalCycles([][]int{tt.want}, false)) {
+				t.Errorf("Digraph.FindCycle() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDigraph_FindAllCycles(t *testing.T) {
+	d, err := NewDigraph(map[int][]int{
+		0: {1, 2},
+		1: {0, 2},
+		2: {0, 2},
+		3: {0},
+	})
+	if err != nil {
+		t.Fatalf("NewDigraph() error = %v", err)
+	}
+
+	got := d.FindAllCycles()
+	want := [][]int{
+		{0, 1},
+		{0, 2},
+		{2},
+		{0, 1, 2},
+	}
+
+	if !reflect.DeepEqual(canonicalCycles(got, false), canonicalCycles(want, false)) {
+		t.Errorf("Digraph.FindAllCycles() = %v, want %v", got, want)
+	}
+}
+
+func isGraphCycle(g *IntGraph, cycle []int) bool {
+	// Going there and back needs two edges between the nodes
+	if len(cycle) == 2 && countOf(g.nodes[cycle[0]], cycle[1]) < 2 {
+		return false
+	}
+
+	for i, node := range cycle {
+		next := cycle[(i+1)%len(cycle)]
+		if !slices.Contains(g.nodes[node], next) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// canonicalCycles rotates every cycle to start from its smallest node, also
+// picking the smaller direction if they are undirected, and sorts the result.
+func canonicalCycles(cycles [][]int, undirected bool) [][]int {
+	result := make([][]int, 0, len(cycles))
+	for _, cycle := range cycles {
+		if len(cycle) == 0 {
+			continue
+		}
+
+		first := slices.Index(cycle, slices.Min(cycle))
+		canonical := slices.Concat(cycle[first:], cycle[:first])
+		if undirected && len(canonical) > 2 && canonical[1] > canonical[len(canonical)-1] {
+			slices.Reverse(canonical[1:])
+		}
+
+		result = append(result, canonical)
+	}
+
+	slices.SortFunc(result, slices.Compare)
+
+	return result
+}
+
+func TestGraph_FindCycle_AgreesWithFindAllCycles(t *testing.T) {
+	graphs := []map[int][]int{
+		{0: {1, 1}, 1: {0, 0}},
+		{0: {1}, 1: {0, 2}, 2: {1}},
+		{0: {0, 0}},
+		{0: {1, 2}, 1: {0, 2}, 2: {0, 1}},
+	}
+	for _, nodes := range graphs {
+		g, err := New(nodes, WithMultiEdges(), WithSelfLoops())
+		if err != nil {
+			t.Fatalf("New() error = %v", err)
+		}
+
+		_, found := g.FindCycle()
+		if all := g.FindAllCycles(); found != (len(all) > 0) {
+			t.Errorf("Graph.FindCycle() found = %v, but Graph.FindAllCycles() = %v for %v", found, all, nodes)
+		}
+	}
+}
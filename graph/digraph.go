@@ -0,0 +1,216 @@
+package graph
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Digraph is a directed graph, where each arc goes one way from a node to its
+// successor. It keeps both outgoing and incoming adjacency so that successors
+// and predecessors of a node can be looked up equally fast.
+type Digraph[K comparable] struct {
+	out map[K][]K
+	in  map[K][]K
+}
+
+// NewDigraph creates an instance of Digraph provided each node's successors,
+// while also ensuring every arc points to an existing node and no arc is
+// repeated. Unlike New, relations do not have to be mutual.
+func NewDigraph[K comparable](arcs map[K][]K) (*Digraph[K], error) {
+	d := Digraph[K]{
+		out: make(map[K][]K, len(arcs)),
+		in:  make(map[K][]K, len(arcs)),
+	}
+
+	for node := range arcs {
+		d.out[node] = []K{}
+		d.in[node] = []K{}
+	}
+
+	for node, successors := range arcs {
+		for _, successor := range successors {
+			if _, ok := arcs[successor]; !ok {
+				return nil, fmt.Errorf("provided arcs are invalid, node %v points to non-existent node %v", node, successor)
+			}
+			if slices.Contains(d.out[node], successor) {
+				return nil, fmt.Errorf("provided arcs are invalid, arc from node %v to node %v is repeated", node, successor)
+			}
+
+			d.out[node] = append(d.out[node], successor)
+			d.in[successor] = append(d.in[successor], node)
+		}
+	}
+
+	return &d, nil
+}
+
+// AddNode adds a new node to the digraph provided its id and successors, if
+// error occurs during the process, the error is returned and no changes are
+// made to the digraph.
+func (d *Digraph[K]) AddNode(nodeID K, successors []K) error {
+	if _, ok := d.out[nodeID]; ok {
+		return fmt.Errorf("node %v already exists", nodeID)
+	}
+
+	for i, successor := range successors {
+		if _, ok := d.out[successor]; !ok && successor != nodeID {
+			return fmt.Errorf("unable to connect new node to non-existent node %v", successor)
+		}
+		if slices.Contains(successors[:i], successor) {
+			return fmt.Errorf("arc from node %v to node %v is repeated", nodeID, successor)
+		}
+	}
+
+	d.out[nodeID] = slices.Clone(successors)
+	if d.out[nodeID] == nil {
+		d.out[nodeID] = []K{}
+	}
+	d.in[nodeID] = []K{}
+
+	for _, successor := range successors {
+		d.in[successor] = append(d.in[successor], nodeID)
+	}
+
+	return nil
+}
+
+// RemoveNode removes a node from the digraph provided its id along with every
+// arc leading to or from it, only returns error if the node already does not
+// exist.
+func (d *Digraph[K]) RemoveNode(nodeID K) error {
+	if _, ok := d.out[nodeID]; !ok {
+		return fmt.Errorf("node %v does not exist", nodeID)
+	}
+
+	for _, successor := range d.out[nodeID] {
+		d.in[successor] = slices.DeleteFunc(d.in[successor], func(node K) bool {
+			return node == nodeID
+		})
+	}
+	for _, predecessor := range d.in[nodeID] {
+		d.out[predecessor] = slices.DeleteFunc(d.out[predecessor], func(node K) bool {
+			return node == nodeID
+		})
+	}
+
+	delete(d.out, nodeID)
+	delete(d.in, nodeID)
+
+	return nil
+}
+
+// AddArc adds an arc to the digraph going from node `from` to node `to`, if
+// error occurs during the process, the error is returned and no changes are
+// made to the digraph.
+func (d *Digraph[K]) AddArc(from, to K) error {
+	if _, ok := d.out[from]; !ok {
+		return fmt.Errorf("node %v does not exist", from)
+	}
+	if _, ok := d.out[to]; !ok {
+		return fmt.Errorf("node %v does not exist", to)
+	}
+	if slices.Contains(d.out[from], to) {
+		return fmt.Errorf("arc from node %v to node %v already exists", from, to)
+	}
+
+	d.out[from] = append(d.out[from], to)
+	d.in[to] = append(d.in[to], from)
+
+	return nil
+}
+
+// RemoveArc removes the arc going from node `from` to node `to`, only returns
+// error if the arc already does not exist, indicating that no removing
+// operation was needed to be performed.
+func (d *Digraph[K]) RemoveArc(from, to K) error {
+	if _, ok := d.out[from]; !ok {
+		return fmt.Errorf("node %v does not exist", from)
+	}
+	if _, ok := d.out[to]; !ok {
+		return fmt.Errorf("node %v does not exist", to)
+	}
+	if !slices.Contains(d.out[from], to) {
+		return fmt.Errorf("arc from node %v to node %v does not exist", from, to)
+	}
+
+	d.out[from] = slices.DeleteFunc(d.out[from], func(node K) bool {
+		return node == to
+	})
+	d.in[to] = slices.DeleteFunc(d.in[to], func(node K) bool {
+		return node == from
+	})
+
+	return nil
+}
+
+// Successors returns the nodes that node `nodeID` has an arc to.
+func (d *Digraph[K]) Successors(nodeID K) ([]K, error) {
+	successors, ok := d.out[nodeID]
+	if !ok {
+		return nil, fmt.Errorf("node %v does not exist", nodeID)
+	}
+
+	return slices.Clone(successors), nil
+}
+
+// Predecessors returns the nodes that have an arc to node `nodeID`.
+func (d *Digraph[K]) Predecessors(nodeID K) ([]K, error) {
+	predecessors, ok := d.in[nodeID]
+	if !ok {
+		return nil, fmt.Errorf("node %v does not exist", nodeID)
+	}
+
+	return slices.Clone(predecessors), nil
+}
+
+// FindShortestPath returns the shortest path following arcs from node `a` to
+// node `b`. If multiple same length paths exist, it will return one of them
+// without any defined logic. As with Graph.FindShortestPath, the path always
+// leaves node `a`, so a path from a node to itself does not exist, even on a
+// cycle.
+func (d *Digraph[K]) FindShortestPath(a, b K) ([]K, error) {
+	if _, ok := d.out[a]; !ok {
+		return nil, fmt.Errorf("start node %v does not exist in the graph", a)
+	}
+	if _, ok := d.out[b]; !ok {
+		return nil, fmt.Errorf("end node %v does not exist in the graph", b)
+	}
+
+	// The start node counts as seen from the beginning, so it is never a
+	// target and a path from a node to itself does not exist
+	prev := map[K]K{a: a}
+	queue := []K{a}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+
+		for _, successor := range d.out[node] {
+			if _, seen := prev[successor]; seen {
+				continue
+			}
+			prev[successor] = node
+
+			// Reached the target
+			if successor == b {
+				return pathTo(prev, a, b), nil
+			}
+
+			queue = append(queue, successor)
+		}
+	}
+
+	return nil, fmt.Errorf("a path from node %v to node %v does not exist", a, b)
+}
+
+// PathExists checks whether a path following arcs from node `a` to node `b`
+// exists. A path from a node to itself does not, see FindShortestPath.
+func (d *Digraph[K]) PathExists(a, b K) bool {
+	path, err := d.FindShortestPath(a, b)
+	return len(path) > 0 && err == nil
+}
+
+// CycleExists checks whether there is any directed cycle in the digraph.
+func (d *Digraph[K]) CycleExists() bool {
+	_, ok := d.FindCycle()
+	return ok
+}
@@ -0,0 +1,570 @@
+package graph
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestNewDigraph(t *testing.T) {
+	tests := []struct {
+		name    string
+		arcs    map[int][]int
+		wantOut map[int][]int
+		wantIn  map[int][]int
+		wantErr bool
+	}{
+		{
+			name: "Should create a digraph with one-way arcs",
+			arcs: map[int][]int{
+				0: {1, 2},
+				1: {2},
+				2: {},
+			},
+			wantOut: map[int][]int{
+				0: {1, 2},
+				1: {2},
+				2: {},
+			},
+			wantIn: map[int][]int{
+				0: {},
+				1: {0},
+				2: {0, 1},
+			},
+		},
+		{
+			name: "Should return error if an arc points to a non-existent node",
+			arcs: map[int][]int{
+				0: {1, 3},
+				1: {},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should return error if an arc is repeated",
+			arcs: map[int][]int{
+				0: {1, 1},
+				1: {},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewDigraph(tt.arcs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewDigraph() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+
+			sortAdjacency(got.out)
+			sortAdjacency(got.in)
+
+			if !reflect.DeepEqual(got.out, tt.wantOut) {
+				t.Errorf("NewDigraph() Digraph.out = %v, want %v", got.out, tt.wantOut)
+			}
+			if !reflect.DeepEqual(got.in, tt.wantIn) {
+				t.Errorf("NewDigraph() Digraph.in = %v, want %v", got.in, tt.wantIn)
+			}
+		})
+	}
+}
+
+func TestDigraph_AddNode(t *testing.T) {
+	type args struct {
+		node       int
+		successors []int
+	}
+	tests := []struct {
+		name    string
+		arcs    map[int][]int
+		args    args
+		wantOut map[int][]int
+		wantIn  map[int][]int
+		wantErr bool
+	}{
+		{
+			name: "Should add a node along with its arcs",
+			arcs: map[int][]int{
+				0: {1},
+				1: {},
+			},
+			args: args{node: 2, successors: []int{0, 2}},
+			wantOut: map[int][]int{
+				0: {1},
+				1: {},
+				2: {0, 2},
+			},
+			wantIn: map[int][]int{
+				0: {2},
+				1: {0},
+				2: {2},
+			},
+		},
+		{
+			name: "Should return error if the node already exists",
+			arcs: map[int][]int{
+				0: {1},
+				1: {},
+			},
+			args: args{node: 1, successors: []int{0}},
+			wantOut: map[int][]int{
+				0: {1},
+				1: {},
+			},
+			wantIn: map[int][]int{
+				0: {},
+				1: {0},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should return error if a successor does not exist",
+			arcs: map[int][]int{
+				0: {1},
+				1: {},
+			},
+			args: args{node: 2, successors: []int{0, 5}},
+			wantOut: map[int][]int{
+				0: {1},
+				1: {},
+			},
+			wantIn: map[int][]int{
+				0: {},
+				1: {0},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := NewDigraph(tt.arcs)
+			if err != nil {
+				t.Fatalf("NewDigraph() error = %v", err)
+			}
+
+			if err := d.AddNode(tt.args.node, tt.args.successors); (err != nil) != tt.wantErr {
+				t.Errorf("Digraph.AddNode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			sortAdjacency(d.out)
+			sortAdjacency(d.in)
+
+			if !reflect.DeepEqual(d.out, tt.wantOut) {
+				t.Errorf("Digraph.AddNode() Digraph.out = %v, want %v", d.out, tt.wantOut)
+			}
+			if !reflect.DeepEqual(d.in, tt.wantIn) {
+				t.Errorf("Digraph.AddNode() Digraph.in = %v, want %v", d.in, tt.wantIn)
+			}
+		})
+	}
+}
+
+func TestDigraph_RemoveNode(t *testing.T) {
+	tests := []struct {
+		name       string
+		arcs       map[int][]int
+		targetNode int
+		wantOut    map[int][]int
+		wantIn     map[int][]int
+		wantErr    bool
+	}{
+		{
+			name: "Should remove a node and all arcs leading to or from it",
+			arcs: map[int][]int{
+				0: {1},
+				1: {2},
+				2: {0},
+			},
+			targetNode: 1,
+			wantOut: map[int][]int{
+				0: {},
+				2: {0},
+			},
+			wantIn: map[int][]int{
+				0: {2},
+				2: {},
+			},
+		},
+		{
+			name: "Should return error if the node does not exist",
+			arcs: map[int][]int{
+				0: {1},
+				1: {},
+			},
+			targetNode: 2,
+			wantOut: map[int][]int{
+				0: {1},
+				1: {},
+			},
+			wantIn: map[int][]int{
+				0: {},
+				1: {0},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := NewDigraph(tt.arcs)
+			if err != nil {
+				t.Fatalf("NewDigraph() error = %v", err)
+			}
+
+			if err := d.RemoveNode(tt.targetNode); (err != nil) != tt.wantErr {
+				t.Errorf("Digraph.RemoveNode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			sortAdjacency(d.out)
+			sortAdjacency(d.in)
+
+			if !reflect.DeepEqual(d.out, tt.wantOut) {
+				t.Errorf("Digraph.RemoveNode() Digraph.out = %v, want %v", d.out, tt.wantOut)
+			}
+			if !reflect.DeepEqual(d.in, tt.wantIn) {
+				t.Errorf("Digraph.RemoveNode() Digraph.in = %v, want %v", d.in, tt.wantIn)
+			}
+		})
+	}
+}
+
+func TestDigraph_AddArc(t *testing.T) {
+	type args struct {
+		from int
+		to   int
+	}
+	tests := []struct {
+		name    string
+		arcs    map[int][]int
+		args    args
+		wantOut map[int][]int
+		wantIn  map[int][]int
+		wantErr bool
+	}{
+		{
+			name: "Should add an arc in one direction only",
+			arcs: map[int][]int{
+				0: {},
+				1: {},
+			},
+			args: args{from: 1, to: 0},
+			wantOut: map[int][]int{
+				0: {},
+				1: {0},
+			},
+			wantIn: map[int][]int{
+				0: {1},
+				1: {},
+			},
+		},
+		{
+			name: "Should return error if the arc already exists",
+			arcs: map[int][]int{
+				0: {1},
+				1: {},
+			},
+			args: args{from: 0, to: 1},
+			wantOut: map[int][]int{
+				0: {1},
+				1: {},
+			},
+			wantIn: map[int][]int{
+				0: {},
+				1: {0},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should return error if node to does not exist",
+			arcs: map[int][]int{
+				0: {},
+			},
+			args: args{from: 0, to: 1},
+			wantOut: map[int][]int{
+				0: {},
+			},
+			wantIn: map[int][]int{
+				0: {},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := NewDigraph(tt.arcs)
+			if err != nil {
+				t.Fatalf("NewDigraph() error = %v", err)
+			}
+
+			if err := d.AddArc(tt.args.from, tt.args.to); (err != nil) != tt.wantErr {
+				t.Errorf("Digraph.AddArc() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			sortAdjacency(d.out)
+			sortAdjacency(d.in)
+
+			if !reflect.DeepEqual(d.out, tt.wantOut) {
+				t.Errorf("Digraph.AddArc() Digraph.out = %v, want %v", d.out, tt.wantOut)
+			}
+			if !reflect.DeepEqual(d.in, tt.wantIn) {
+				t.Errorf("Digraph.AddArc() Digraph.in = %v, want %v", d.in, tt.wantIn)
+			}
+		})
+	}
+}
+
+func TestDigraph_RemoveArc(t *testing.T) {
+	type args struct {
+		from int
+		to   int
+	}
+	tests := []struct {
+		name    string
+		arcs    map[int][]int
+		args    args
+		wantOut map[int][]int
+		wantIn  map[int][]int
+		wantErr bool
+	}{
+		{
+			name: "Should remove the arc but keep the opposite one",
+			arcs: map[int][]int{
+				0: {1},
+				1: {0},
+			},
+			args: args{from: 0, to: 1},
+			wantOut: map[int][]int{
+				0: {},
+				1: {0},
+			},
+			wantIn: map[int][]int{
+				0: {1},
+				1: {},
+			},
+		},
+		{
+			name: "Should return error if the arc only exists the other way",
+			arcs: map[int][]int{
+				0: {1},
+				1: {},
+			},
+			args: args{from: 1, to: 0},
+			wantOut: map[int][]int{
+				0: {1},
+				1: {},
+			},
+			wantIn: map[int][]int{
+				0: {},
+				1: {0},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := NewDigraph(tt.arcs)
+			if err != nil {
+				t.Fatalf("NewDigraph() error = %v", err)
+			}
+
+			if err := d.RemoveArc(tt.args.from, tt.args.to); (err != nil) != tt.wantErr {
+				t.Errorf("Digraph.RemoveArc() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			sortAdjacency(d.out)
+			sortAdjacency(d.in)
+
+			if !reflect.DeepEqual(d.out, tt.wantOut) {
+				t.Errorf("Digraph.RemoveArc() Digraph.out = %v, want %v", d.out, tt.wantOut)
+			}
+			if !reflect.DeepEqual(d.in, tt.wantIn) {
+				t.Errorf("Digraph.RemoveArc() Digraph.in = %v, want %v", d.in, tt.wantIn)
+			}
+		})
+	}
+}
+
+func TestDigraph_FindShortestPath(t *testing.T) {
+	type args struct {
+		a int
+		b int
+	}
+	tests := []struct {
+		name    string
+		arcs    map[int][]int
+		args    args
+		want    []int
+		wantErr bool
+	}{
+		{
+			name: "Should find shortest path following arcs",
+			arcs: map[int][]int{
+				0: {1, 3},
+				1: {2},
+				2: {4},
+				3: {4},
+				4: {},
+			},
+			args: args{a: 0, b: 4},
+			want: []int{0, 3, 4},
+		},
+		{
+			name: "Should return error if only a reverse path exists",
+			arcs: map[int][]int{
+				0: {1, 3},
+				1: {2},
+				2: {4},
+				3: {4},
+				4: {},
+			},
+			args:    args{a: 4, b: 0},
+			wantErr: true,
+		},
+		{
+			name: "Should return error for a path from a node to itself",
+			arcs: map[int][]int{
+				0: {1},
+				1: {0},
+			},
+			args:    args{a: 0, b: 0},
+			wantErr: true,
+		},
+		{
+			name: "Should return error if starting node does not exist",
+			arcs: map[int][]int{
+				0: {},
+			},
+			args:    args{a: 9, b: 0},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := NewDigraph(tt.arcs)
+			if err != nil {
+				t.Fatalf("NewDigraph() error = %v", err)
+			}
+
+			got, err := d.FindShortestPath(tt.args.a, tt.args.b)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Digraph.FindShortestPath() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Digraph.FindShortestPath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDigraph_PathExists(t *testing.T) {
+	type args struct {
+		a int
+		b int
+	}
+	tests := []struct {
+		name string
+		arcs map[int][]int
+		args args
+		want bool
+	}{
+		{
+			name: "Should return true if a path exists",
+			arcs: map[int][]int{
+				0: {1},
+				1: {2},
+				2: {},
+			},
+			args: args{a: 0, b: 2},
+			want: true,
+		},
+		{
+			name: "Should return false if a path only exists the other way",
+			arcs: map[int][]int{
+				0: {1},
+				1: {2},
+				2: {},
+			},
+			args: args{a: 2, b: 0},
+			want: false,
+		},
+		{
+			name: "Should return false for a path from a node to itself",
+			arcs: map[int][]int{
+				0: {1},
+				1: {0},
+			},
+			args: args{a: 0, b: 0},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := NewDigraph(tt.arcs)
+			if err != nil {
+				t.Fatalf("NewDigraph() error = %v", err)
+			}
+
+			if got := d.PathExists(tt.args.a, tt.args.b); got != tt.want {
+				t.Errorf("Digraph.PathExists() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDigraph_CycleExists(t *testing.T) {
+	tests := []struct {
+		name string
+		arcs map[int][]int
+		want bool
+	}{
+		{
+			name: "Should return true if a cycle exists",
+			arcs: map[int][]int{
+				0: {1},
+				1: {2},
+				2: {0},
+				3: {0},
+			},
+			want: true,
+		},
+		{
+			name: "Should return true for a self-loop",
+			arcs: map[int][]int{
+				0: {0},
+			},
+			want: true,
+		},
+		{
+			name: "Should return false for a diamond without a cycle",
+			arcs: map[int][]int{
+				0: {1, 2},
+				1: {3},
+				2: {3},
+				3: {},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := NewDigraph(tt.arcs)
+			if err != nil {
+				t.Fatalf("NewDigraph() error = %v", err)
+			}
+
+			if got := d.CycleExists(); got != tt.want {
+				t.Errorf("Digraph.CycleExists() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func sortAdjacency(nodes map[int][]int) {
+	for node := range nodes {
+		slices.Sort(nodes[node])
+	}
+}
@@ -0,0 +1,101 @@
+package graph
+
+import (
+	"container/heap"
+	"fmt"
+	"slices"
+)
+
+// FindShortestWeightedPath returns the path between node `a` and node `b` with
+// the lowest total weight along with that weight, using Dijkstra's algorithm.
+// Edges added without a weight weigh 1, so on an unweighted graph the cost is
+// the number of edges in the path. As with FindShortestPath, the path always
+// leaves node `a`, so a path from a node to itself does not exist.
+func (g *Graph[K]) FindShortestWeightedPath(a, b K) ([]K, float64, error) {
+	if _, ok := g.nodes[a]; !ok {
+		return nil, 0, fmt.Errorf("start node %v does not exist in the graph", a)
+	}
+	if _, ok := g.nodes[b]; !ok {
+		return nil, 0, fmt.Errorf("end node %v does not exist in the graph", b)
+	}
+	if a == b {
+		return nil, 0, fmt.Errorf("a path between node %v and node %v does not exist", a, b)
+	}
+
+	dist := map[K]float64{a: 0}
+	prev := make(map[K]K)
+	visited := make(map[K]bool)
+
+	queue := &priorityQueue[K]{{node: a, priority: 0}}
+	for queue.Len() > 0 {
+		item := heap.Pop(queue).(queueItem[K])
+
+		// Stale entry, the node was already settled through a cheaper path
+		if visited[item.node] {
+			continue
+		}
+		visited[item.node] = true
+
+		// Reached the target
+		if item.node == b {
+			path := []K{b}
+			for node := b; node != a; {
+				node = prev[node]
+				path = append(path, node)
+			}
+
+			slices.Reverse(path)
+
+			return path, item.priority, nil
+		}
+
+		for _, neighbor := range g.nodes[item.node] {
+			if visited[neighbor] {
+				continue
+			}
+
+			candidate := item.priority + g.weight(item.node, neighbor)
+			if current, ok := dist[neighbor]; ok && current <= candidate {
+				continue
+			}
+
+			dist[neighbor] = candidate
+			prev[neighbor] = item.node
+			heap.Push(queue, queueItem[K]{node: neighbor, priority: candidate})
+		}
+	}
+
+	return nil, 0, fmt.Errorf("a path between node %v and node %v does not exist", a, b)
+}
+
+type queueItem[K comparable] struct {
+	node     K
+	priority float64
+}
+
+// priorityQueue is a binary min-heap of nodes ordered by their priority,
+// implementing heap.Interface.
+type priorityQueue[K comparable] []queueItem[K]
+
+func (q priorityQueue[K]) Len() int {
+	return len(q)
+}
+
+func (q priorityQueue[K]) Less(i, j int) bool {
+	return q[i].priority < q[j].priority
+}
+
+func (q priorityQueue[K]) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+}
+
+func (q *priorityQueue[K]) Push(x any) {
+	*q = append(*q, x.(queueItem[K]))
+}
+
+func (q *priorityQueue[K]) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
@@ -0,0 +1,121 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGraph_FindShortestWeightedPath(t *testing.T) {
+	type args struct {
+		a int
+		b int
+	}
+	tests := []struct {
+		name     string
+		graph    IntGraph
+		args     args
+		want     []int
+		wantCost float64
+		wantErr  bool
+	}{
+		{
+			name: "Should treat every edge as weight 1 on an unweighted graph",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {4, 6, 8},
+					1: {2, 5, 8},
+					2: {1, 3},
+					3: {2, 7},
+					4: {0, 5},
+					5: {1, 4},
+					6: {0, 7},
+					7: {3, 6},
+					8: {0, 1},
+				},
+			},
+			args:     args{a: 0, b: 3},
+			want:     []int{0, 6, 7, 3},
+			wantCost: 3,
+		},
+		{
+			name: "Should prefer a longer path with a lower total weight",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {4, 6, 8},
+					1: {2, 5, 8},
+					2: {1, 3},
+					3: {2, 7},
+					4: {0, 5},
+					5: {1, 4},
+					6: {0, 7},
+					7: {3, 6},
+					8: {0, 1},
+				},
+				weights: map[int]map[int]float64{
+					6: {7: 10},
+					7: {6: 10},
+				},
+			},
+			args:     args{a: 0, b: 3},
+			want:     []int{0, 8, 1, 2, 3},
+			wantCost: 4,
+		},
+		{
+			name: "Should return error if both ends are the same",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1},
+					1: {0},
+				},
+			},
+			args:    args{a: 0, b: 0},
+			wantErr: true,
+		},
+		{
+			name: "Should return error if starting node does not exist",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1},
+					1: {0},
+				},
+			},
+			args:    args{a: 9, b: 0},
+			wantErr: true,
+		},
+		{
+			name: "Should return error if a path does not exist",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0:  {4, 6, 8},
+					1:  {2, 5, 8},
+					2:  {1, 3},
+					3:  {2, 7},
+					4:  {0, 5},
+					5:  {1, 4},
+					6:  {0, 7},
+					7:  {3, 6},
+					8:  {0, 1},
+					9:  {10},
+					10: {9},
+				},
+			},
+			args:    args{a: 0, b: 9},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotCost, err := tt.graph.FindShortestWeightedPath(tt.args.a, tt.args.b)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Graph.FindShortestWeightedPath() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Graph.FindShortestWeightedPath() path = %v, want %v", got, tt.want)
+			}
+			if gotCost != tt.wantCost {
+				t.Errorf("Graph.FindShortestWeightedPath() cost = %v, want %v", gotCost, tt.wantCost)
+			}
+		})
+	}
+}
@@ -0,0 +1,437 @@
+package graph
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// WriteDOT writes the graph in the Graphviz DOT language as an undirected
+// graph, listing every node and then every edge once. Weighted edges carry a
+// weight attribute, and node and edge attributes are written along with them.
+func (g *Graph[K]) WriteDOT(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintln(bw, "graph {")
+	for _, node := range g.sortedNodes() {
+		fmt.Fprintf(bw, "\t%s%s;\n", dotID(node), dotAttrList(nil, g.nodeAttrs[node]))
+	}
+	for _, edge := range g.sortedEdges() {
+		var weight []string
+		if value, ok := g.weights[edge[0]][edge[1]]; ok {
+			weight = []string{"weight=" + strconv.FormatFloat(value, 'g', -1, 64)}
+		}
+
+		fmt.Fprintf(bw, "\t%s -- %s%s;\n", dotID(edge[0]), dotID(edge[1]), dotAttrList(weight, g.edgeAttrs[edge[0]][edge[1]]))
+	}
+	fmt.Fprintln(bw, "}")
+
+	return bw.Flush()
+}
+
+// dotAttrList formats an attribute list such as [weight=2, color=red], adding
+// attributes in key order after the given leading ones. An attribute named
+// weight is left out, as it would clash with the edge weight.
+func dotAttrList(leading []string, attrs map[string]any) string {
+	list := leading
+	for _, key := range slices.Sorted(maps.Keys(attrs)) {
+		if key == "weight" {
+			continue
+		}
+
+		list = append(list, dotID(key)+"="+dotID(textualAttr(attrs[key])))
+	}
+
+	if len(list) == 0 {
+		return ""
+	}
+
+	return " [" + strings.Join(list, ", ") + "]"
+}
+
+var dotPlainID = regexp.MustCompile(`^([A-Za-z_][A-Za-z0-9_]*|-?(\.[0-9]+|[0-9]+(\.[0-9]*)?))$`)
+
+// dotKeywords are the words DOT reserves, in any case, which have to be quoted
+// to be used as identifiers.
+var dotKeywords = []string{"node", "edge", "graph", "digraph", "subgraph", "strict"}
+
+// dotID formats a node as a DOT identifier, quoting it unless it is a plain
+// name or number that is not a keyword.
+func dotID[K comparable](node K) string {
+	id := fmt.Sprint(node)
+	isKeyword := slices.ContainsFunc(dotKeywords, func(keyword string) bool {
+		return strings.EqualFold(id, keyword)
+	})
+	if dotPlainID.MatchString(id) && !isKeyword {
+		return id
+	}
+
+	return dotQuote(id)
+}
+
+// dotQuote quotes the text as a DOT string. DOT only escapes quotes, any other
+// backslash is kept as it is, so a backslash that would otherwise end the
+// string or a line is followed by a backslash and a line break, which DOT
+// drops.
+func dotQuote(text string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i := 0; i < len(text); i++ {
+		switch c := text[i]; {
+		case c == '"':
+			b.WriteString(`\"`)
+		case c == '\\' && (i+1 == len(text) || text[i+1] == '\n' || text[i+1] == '\r'):
+			b.WriteString("\\\\\n")
+		default:
+			b.WriteByte(c)
+		}
+	}
+	b.WriteByte('"')
+
+	return b.String()
+}
+
+// ReadDOT reads an undirected graph written in the Graphviz DOT language,
+// turning every node identifier into a node with `parse`. Only node and edge
+// statements are understood, edges may be chained and may carry a weight
+// attribute. Other attributes of nodes and edges are kept as attributes, while
+// defaults set by node, edge and graph statements are ignored. The resulting
+// relations are validated the same way New does, against the edge policy set
+// by `opts`.
+func ReadDOT[K comparable](r io.Reader, parse func(id string) (K, error), opts ...Option) (*Graph[K], error) {
+	src, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("error reading DOT: %w", err)
+	}
+
+	tokens, err := dotTokens(string(src))
+	if err != nil {
+		return nil, err
+	}
+
+	p := dotParser{tokens: tokens}
+
+	// Header: [strict] graph [name] {
+	if p.peek() == "strict" {
+		p.next()
+	}
+	switch p.next().text {
+	case "graph":
+	case "digraph":
+		return nil, fmt.Errorf("error parsing DOT: expected an undirected graph, got a digraph")
+	default:
+		return nil, fmt.Errorf("error parsing DOT: expected graph keyword")
+	}
+	if p.peek() != "{" {
+		p.next()
+	}
+	if p.next().text != "{" {
+		return nil, fmt.Errorf("error parsing DOT: expected { after graph header")
+	}
+
+	nodes := make(map[K][]K)
+	weights := make(map[[2]K]float64)
+	nodeAttrs := make(map[K]map[string]string)
+	edgeAttrs := make(map[[2]K]map[string]string)
+	node := func(id string) (K, error) {
+		n, err := parse(id)
+		if err != nil {
+			return n, fmt.Errorf("error parsing DOT node %q: %w", id, err)
+		}
+		if _, ok := nodes[n]; !ok {
+			nodes[n] = []K{}
+		}
+
+		return n, nil
+	}
+
+	for {
+		if p.done() {
+			return nil, fmt.Errorf("error parsing DOT: unexpected end of input, expected }")
+		}
+
+		switch p.peek() {
+		case "}":
+			g, err := New(nodes, opts...)
+			if err != nil {
+				return nil, err
+			}
+			for edge, weight := range weights {
+				g.setWeight(edge[0], edge[1], weight)
+				g.setWeight(edge[1], edge[0], weight)
+			}
+			for n, attrs := range nodeAttrs {
+				for key, value := range attrs {
+					if err := g.SetNodeAttr(n, key, textAttr(value)); err != nil {
+						return nil, err
+					}
+				}
+			}
+			for edge, attrs := range edgeAttrs {
+				for key, value := range attrs {
+					if err := g.SetEdgeAttr(edge[0], edge[1], key, textAttr(value)); err != nil {
+						return nil, err
+					}
+				}
+			}
+
+			return g, nil
+		case ";":
+			p.next()
+			continue
+		}
+
+		// Default graph, node and edge attributes are ignored
+		keyword := p.peek()
+		if keyword == "graph" || keyword == "node" || keyword == "edge" {
+			p.next()
+			if _, err := p.attributes(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		first, ok := p.id()
+		if !ok {
+			return nil, fmt.Errorf("error parsing DOT: unexpected %q", first)
+		}
+
+		// Graph attributes such as rankdir=LR are ignored
+		if p.peek() == "=" {
+			p.next()
+			if value, ok := p.id(); !ok {
+				return nil, fmt.Errorf("error parsing DOT: invalid value for attribute %q", value)
+			}
+			continue
+		}
+
+		chain := []string{first}
+		for p.peek() == "--" {
+			p.next()
+			id, ok := p.id()
+			if !ok {
+				return nil, fmt.Errorf("error parsing DOT: expected node after --, got %q", id)
+			}
+			chain = append(chain, id)
+		}
+		if p.peek() == "->" {
+			return nil, fmt.Errorf("error parsing DOT: directed edges are not supported")
+		}
+
+		attrs, err := p.attributes()
+		if err != nil {
+			return nil, err
+		}
+
+		var weight float64
+		weighted := false
+		if value, ok := attrs["weight"]; ok && len(chain) > 1 {
+			weight, err = strconv.ParseFloat(value, 64)
+			if err != nil || weight < 0 || math.IsNaN(weight) {
+				return nil, fmt.Errorf("error parsing DOT: invalid weight %q, expected a non-negative number", value)
+			}
+			weighted = true
+		}
+
+		delete(attrs, "weight")
+
+		prev, err := node(chain[0])
+		if err != nil {
+			return nil, err
+		}
+		if len(chain) == 1 && len(attrs) > 0 {
+			if nodeAttrs[prev] == nil {
+				nodeAttrs[prev] = make(map[string]string)
+			}
+			maps.Copy(nodeAttrs[prev], attrs)
+		}
+
+		for _, id := range chain[1:] {
+			current, err := node(id)
+			if err != nil {
+				return nil, err
+			}
+
+			nodes[prev] = append(nodes[prev], current)
+			nodes[current] = append(nodes[current], prev)
+			if weighted {
+				if err := addReadWeight(weights, prev, current, weight); err != nil {
+					return nil, fmt.Errorf("error parsing DOT: %w", err)
+				}
+			}
+			if len(attrs) > 0 {
+				edge := [2]K{prev, current}
+				if edgeAttrs[edge] == nil {
+					edgeAttrs[edge] = make(map[string]string)
+				}
+				maps.Copy(edgeAttrs[edge], attrs)
+			}
+
+			prev = current
+		}
+	}
+}
+
+type dotToken struct {
+	text   string
+	quoted bool
+}
+
+type dotParser struct {
+	tokens []dotToken
+	pos    int
+}
+
+func (p *dotParser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *dotParser) peekToken() dotToken {
+	if p.done() {
+		return dotToken{}
+	}
+
+	return p.tokens[p.pos]
+}
+
+// peek returns the upcoming punctuation or keyword, quoted identifiers never
+// match one.
+func (p *dotParser) peek() string {
+	token := p.peekToken()
+	if token.quoted {
+		return ""
+	}
+
+	return token.text
+}
+
+func (p *dotParser) next() dotToken {
+	token := p.peekToken()
+	p.pos++
+	return token
+}
+
+// id consumes the next token, which must be an identifier.
+func (p *dotParser) id() (string, bool) {
+	token := p.next()
+	if token.quoted {
+		return token.text, true
+	}
+
+	switch token.text {
+	case "", "{", "}", "[", "]", "=", ";", ",", "--", "->":
+		return token.text, false
+	}
+
+	return token.text, true
+}
+
+// attributes parses an optional attribute list such as [weight=2, color=red].
+func (p *dotParser) attributes() (map[string]string, error) {
+	attrs := make(map[string]string)
+	for p.peek() == "[" {
+		p.next()
+		for p.peek() != "]" {
+			key, ok := p.id()
+			if !ok {
+				return nil, fmt.Errorf("error parsing DOT: unexpected %q in attribute list", key)
+			}
+			if p.next().text != "=" {
+				return nil, fmt.Errorf("error parsing DOT: expected = after attribute %q", key)
+			}
+			value, ok := p.id()
+			if !ok {
+				return nil, fmt.Errorf("error parsing DOT: invalid value for attribute %q", key)
+			}
+			attrs[key] = value
+
+			if p.peek() == "," || p.peek() == ";" {
+				p.next()
+			}
+		}
+		p.next()
+	}
+
+	return attrs, nil
+}
+
+// dotTokens splits DOT source into tokens, unquoting quoted identifiers and
+// dropping comments.
+func dotTokens(src string) ([]dotToken, error) {
+	var tokens []dotToken
+	for i := 0; i < len(src); {
+		switch c := src[i]; {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '#' || strings.HasPrefix(src[i:], "//"):
+			end := strings.IndexByte(src[i:], '\n')
+			if end == -1 {
+				end = len(src) - i
+			}
+			i += end
+		case strings.HasPrefix(src[i:], "/*"):
+			end := strings.Index(src[i+2:], "*/")
+			if end == -1 {
+				return nil, fmt.Errorf("error parsing DOT: unterminated comment")
+			}
+			i += 2 + end + 2
+		case strings.IndexByte(dotPunctuation, c) != -1:
+			tokens = append(tokens, dotToken{text: string(c)})
+			i++
+		case strings.HasPrefix(src[i:], "--") || strings.HasPrefix(src[i:], "->"):
+			tokens = append(tokens, dotToken{text: src[i : i+2]})
+			i += 2
+		case c == '"':
+			id, n, err := dotString(src[i:])
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, dotToken{text: id, quoted: true})
+			i += n
+		default:
+			j := i
+			for j < len(src) && !strings.ContainsRune(" \t\n\r\"#"+dotPunctuation, rune(src[j])) &&
+				!strings.HasPrefix(src[j:], "--") && !strings.HasPrefix(src[j:], "->") {
+				j++
+			}
+			tokens = append(tokens, dotToken{text: src[i:j]})
+			i = j
+		}
+	}
+
+	return tokens, nil
+}
+
+// dotString reads the quoted string at the start of `src`, returning its text
+// and its length in `src`. As in DOT, \" is the only escape and a backslash
+// at the end of a line joins it with the next one, while any other backslash
+// is kept as it is, such as in the \l and \N escapes Graphviz gives meaning
+// to in labels.
+func dotString(src string) (string, int, error) {
+	var b strings.Builder
+	for i := 1; i < len(src); i++ {
+		switch {
+		case src[i] == '"':
+			return b.String(), i + 1, nil
+		case src[i] == '\\' && strings.HasPrefix(src[i+1:], `"`):
+			b.WriteByte('"')
+			i++
+		case src[i] == '\\' && strings.HasPrefix(src[i+1:], "\n"):
+			i++
+		case src[i] == '\\' && strings.HasPrefix(src[i+1:], "\r\n"):
+			i += 2
+		default:
+			b.WriteByte(src[i])
+		}
+	}
+
+	return "", 0, fmt.Errorf("error parsing DOT: unterminated string")
+}
+
+const dotPunctuation = "{}[]=;,"
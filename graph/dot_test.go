@@ -0,0 +1,256 @@
+package graph
+
+import (
+	"bytes"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestGraph_WriteDOT(t *testing.T) {
+	g := Graph[string]{
+		nodes: map[string][]string{
+			"api":          {"db", "db", "auth service"},
+			"db":           {"api", "api"},
+			"auth service": {"api", "auth service", "auth service"},
+			"cache":        {},
+		},
+		weights: map[string]map[string]float64{
+			"api":          {"auth service": 2.5},
+			"auth service": {"api": 2.5},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := g.WriteDOT(&buf); err != nil {
+		t.Fatalf("Graph.WriteDOT() error = %v", err)
+	}
+
+	want := `graph {
+	api;
+	"auth service";
+	cache;
+	db;
+	api -- "auth service" [weight=2.5];
+	api -- db;
+	api -- db;
+	"auth service" -- "auth service";
+}
+`
+	if got := buf.String(); got != want {
+		t.Errorf("Graph.WriteDOT() = %s, want %s", got, want)
+	}
+}
+
+func TestReadDOT(t *testing.T) {
+	tests := []struct {
+		name        string
+		src         string
+		wantNodes   map[int][]int
+		wantWeights map[int]map[int]float64
+		wantErr     bool
+	}{
+		{
+			name: "Should read nodes, chained edges and weights",
+			src: `strict graph network {
+				// Defaults are ignored
+				node [shape=circle];
+				rankdir=LR
+				0; 4
+				0 -- 1 -- 2 [weight=3, color="red"]
+				"2" -- 3 /* quoted */ # trailing
+			}`,
+			wantNodes: map[int][]int{
+				0: {1},
+				1: {0, 2},
+				2: {1, 3},
+				3: {2},
+				4: {},
+			},
+			wantWeights: map[int]map[int]float64{
+				0: {1: 3},
+				1: {0: 3, 2: 3},
+				2: {1: 3},
+			},
+		},
+		{
+			name:    "Should return error for a digraph",
+			src:     `digraph { 0 -> 1 }`,
+			wantErr: true,
+		},
+		{
+			name:    "Should return error for a directed edge",
+			src:     `graph { 0 -> 1 }`,
+			wantErr: true,
+		},
+		{
+			name:    "Should return error for a negative weight",
+			src:     `graph { 0 -- 1 [weight=-2] }`,
+			wantErr: true,
+		},
+		{
+			name:    "Should return error if a node can't be parsed",
+			src:     `graph { 0 -- a }`,
+			wantErr: true,
+		},
+		{
+			name:    "Should return error if the graph is not closed",
+			src:     `graph { 0 -- 1`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadDOT(strings.NewReader(tt.src), strconv.Atoi)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ReadDOT() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+
+			sortAdjacency(got.nodes)
+
+			if !reflect.DeepEqual(got.nodes, tt.wantNodes) {
+				t.Errorf("ReadDOT() Graph.nodes = %v, want %v", got.nodes, tt.wantNodes)
+			}
+			if !reflect.DeepEqual(got.weights, tt.wantWeights) {
+				t.Errorf("ReadDOT() Graph.weights = %v, want %v", got.weights, tt.wantWeights)
+			}
+		})
+	}
+}
+
+func TestGraph_DOTRoundTrip(t *testing.T) {
+	g := Graph[string]{
+		nodes: map[string][]string{
+			"api":          {"db", "auth service"},
+			"db":           {"api"},
+			"auth service": {"api"},
+			"cache":        {},
+		},
+		weights: map[string]map[string]float64{
+			"api":          {"auth service": 2.5},
+			"auth service": {"api": 2.5},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := g.WriteDOT(&buf); err != nil {
+		t.Fatalf("Graph.WriteDOT() error = %v", err)
+	}
+
+	got, err := ReadDOT(&buf, func(id string) (string, error) {
+		return id, nil
+	})
+	if err != nil {
+		t.Fatalf("ReadDOT() error = %v", err)
+	}
+
+	for node := range got.nodes {
+		slices.Sort(got.nodes[node])
+		slices.Sort(g.nodes[node])
+	}
+
+	if !reflect.DeepEqual(got.nodes, g.nodes) {
+		t.Errorf("DOT round trip Graph.nodes = %v, want %v", got.nodes, g.nodes)
+	}
+	if !reflect.DeepEqual(got.weights, g.weights) {
+		t.Errorf("DOT round trip Graph.weights = %v, want %v", got.weights, g.weights)
+	}
+}
+
+func TestGraph_DOTRoundTripKeywords(t *testing.T) {
+	g := Graph[string]{
+		nodes: map[string][]string{
+			"node":     {"edge", "Graph"},
+			"edge":     {"node"},
+			"Graph":    {"node", "STRICT"},
+			"STRICT":   {"Graph"},
+			"digraph":  {},
+			"subgraph": {},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := g.WriteDOT(&buf); err != nil {
+		t.Fatalf("Graph.WriteDOT() error = %v", err)
+	}
+
+	got, err := ReadDOT(&buf, func(id string) (string, error) {
+		return id, nil
+	})
+	if err != nil {
+		t.Fatalf("ReadDOT() error = %v", err)
+	}
+
+	for node := range got.nodes {
+		slices.Sort(got.nodes[node])
+		slices.Sort(g.nodes[node])
+	}
+
+	if !reflect.DeepEqual(got.nodes, g.nodes) {
+		t.Errorf("DOT round trip Graph.nodes = %v, want %v", got.nodes, g.nodes)
+	}
+}
+
+func TestReadDOT_Escapes(t *testing.T) {
+	src := `graph {
+	a [label="left\lright\l"];
+	"say \"hi\"" [label="\N"];
+	a -- "say \"hi\"" [label="long \
+line"];
+}
+`
+	g, err := ReadDOT(strings.NewReader(src), func(id string) (string, error) {
+		return id, nil
+	})
+	if err != nil {
+		t.Fatalf("ReadDOT() error = %v", err)
+	}
+
+	if got, err := NodeAttr[string](g, "a", "label"); err != nil || got != `left\lright\l` {
+		t.Errorf("NodeAttr(a, label) = %q, %v, want %q", got, err, `left\lright\l`)
+	}
+	if got, err := NodeAttr[string](g, `say "hi"`, "label"); err != nil || got != `\N` {
+		t.Errorf("NodeAttr(say \"hi\", label) = %q, %v, want %q", got, err, `\N`)
+	}
+	if got, err := EdgeAttr[string](g, "a", `say "hi"`, "label"); err != nil || got != "long line" {
+		t.Errorf("EdgeAttr(a, say \"hi\", label) = %q, %v, want %q", got, err, "long line")
+	}
+}
+
+func TestGraph_DOTRoundTripEscapes(t *testing.T) {
+	ids := []string{`a"b`, `back\`, `back\"quote`, "back\\\nline", "new\nline", "tab\tbell\a", `\N`}
+
+	nodes := make(map[string][]string, len(ids))
+	for _, id := range ids {
+		nodes[id] = []string{}
+	}
+	g, err := New(nodes)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := g.WriteDOT(&buf); err != nil {
+		t.Fatalf("Graph.WriteDOT() error = %v", err)
+	}
+	if strings.Contains(buf.String(), `\x`) || strings.Contains(buf.String(), `\u`) {
+		t.Errorf("Graph.WriteDOT() = %s, want no Go escapes", buf.String())
+	}
+
+	got, err := ReadDOT(&buf, func(id string) (string, error) {
+		return id, nil
+	})
+	if err != nil {
+		t.Fatalf("ReadDOT() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(got.nodes, g.nodes) {
+		t.Errorf("DOT round trip Graph.nodes = %q, want %q", got.nodes, g.nodes)
+	}
+}
@@ -0,0 +1,107 @@
+package graph
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// WriteEdgeList writes the graph as a plain edge list, one edge per line as two
+// space separated nodes followed by the weight for weighted edges. Nodes
+// without any edges are written on a line of their own. Nodes are formatted
+// with fmt, so their text must not contain whitespace.
+func (g *Graph[K]) WriteEdgeList(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+
+	for _, node := range g.sortedNodes() {
+		if len(g.nodes[node]) == 0 {
+			fmt.Fprintln(bw, node)
+		}
+	}
+	for _, edge := range g.sortedEdges() {
+		fmt.Fprintf(bw, "%v %v", edge[0], edge[1])
+		if weight, ok := g.weights[edge[0]][edge[1]]; ok {
+			fmt.Fprintf(bw, " %s", strconv.FormatFloat(weight, 'g', -1, 64))
+		}
+		fmt.Fprintln(bw)
+	}
+
+	return bw.Flush()
+}
+
+// ReadEdgeList reads a graph written as a plain edge list, turning every node
+// field into a node with `parse`. Each line holds either a single node, or two
+// nodes forming an edge with an optional weight. Blank lines and lines
+// starting with # are skipped. The resulting relations are validated the same
+// way New does, against the edge policy set by `opts`.
+func ReadEdgeList[K comparable](r io.Reader, parse func(field string) (K, error), opts ...Option) (*Graph[K], error) {
+	nodes := make(map[K][]K)
+	weights := make(map[[2]K]float64)
+
+	node := func(line int, field string) (K, error) {
+		n, err := parse(field)
+		if err != nil {
+			return n, fmt.Errorf("error parsing edge list line %d: invalid node %q: %w", line, field, err)
+		}
+		if _, ok := nodes[n]; !ok {
+			nodes[n] = []K{}
+		}
+
+		return n, nil
+	}
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		if len(fields) > 3 {
+			return nil, fmt.Errorf("error parsing edge list line %d: expected at most 3 fields, got %d", line, len(fields))
+		}
+
+		a, err := node(line, fields[0])
+		if err != nil {
+			return nil, err
+		}
+		if len(fields) == 1 {
+			continue
+		}
+
+		b, err := node(line, fields[1])
+		if err != nil {
+			return nil, err
+		}
+
+		nodes[a] = append(nodes[a], b)
+		nodes[b] = append(nodes[b], a)
+
+		if len(fields) == 3 {
+			weight, err := strconv.ParseFloat(fields[2], 64)
+			if err != nil || weight < 0 || math.IsNaN(weight) {
+				return nil, fmt.Errorf("error parsing edge list line %d: invalid weight %q, expected a non-negative number", line, fields[2])
+			}
+
+			if err := addReadWeight(weights, a, b, weight); err != nil {
+				return nil, fmt.Errorf("error parsing edge list line %d: %w", line, err)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading edge list: %w", err)
+	}
+
+	g, err := New(nodes, opts...)
+	if err != nil {
+		return nil, err
+	}
+	for edge, weight := range weights {
+		g.setWeight(edge[0], edge[1], weight)
+		g.setWeight(edge[1], edge[0], weight)
+	}
+
+	return g, nil
+}
@@ -0,0 +1,100 @@
+package graph
+
+import (
+	"bytes"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestGraph_WriteEdgeList(t *testing.T) {
+	g := IntGraph{
+		nodes: map[int][]int{
+			0:  {4, 6},
+			4:  {0},
+			6:  {0},
+			10: {},
+		},
+		weights: map[int]map[int]float64{
+			0: {6: 0.5},
+			6: {0: 0.5},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := g.WriteEdgeList(&buf); err != nil {
+		t.Fatalf("Graph.WriteEdgeList() error = %v", err)
+	}
+
+	want := "10\n0 4\n0 6 0.5\n"
+	if got := buf.String(); got != want {
+		t.Errorf("Graph.WriteEdgeList() = %q, want %q", got, want)
+	}
+}
+
+func TestReadEdgeList(t *testing.T) {
+	tests := []struct {
+		name        string
+		src         string
+		wantNodes   map[int][]int
+		wantWeights map[int]map[int]float64
+		wantErr     bool
+	}{
+		{
+			name: "Should read nodes, edges and weights",
+			src: `# network
+10
+
+0 4
+0 6 0.5
+`,
+			wantNodes: map[int][]int{
+				0:  {4, 6},
+				4:  {0},
+				6:  {0},
+				10: {},
+			},
+			wantWeights: map[int]map[int]float64{
+				0: {6: 0.5},
+				6: {0: 0.5},
+			},
+		},
+		{
+			name:    "Should return error for a negative weight",
+			src:     "0 1 -1\n",
+			wantErr: true,
+		},
+		{
+			name:    "Should return error for too many fields",
+			src:     "0 1 2 3\n",
+			wantErr: true,
+		},
+		{
+			name:    "Should return error if a node can't be parsed",
+			src:     "0 x\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadEdgeList(strings.NewReader(tt.src), strconv.Atoi)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ReadEdgeList() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+
+			sortAdjacency(got.nodes)
+
+			if !reflect.DeepEqual(got.nodes, tt.wantNodes) {
+				t.Errorf("ReadEdgeList() Graph.nodes = %v, want %v", got.nodes, tt.wantNodes)
+			}
+			if !reflect.DeepEqual(got.weights, tt.wantWeights) {
+				t.Errorf("ReadEdgeList() Graph.weights = %v, want %v", got.weights, tt.wantWeights)
+			}
+		})
+	}
+}
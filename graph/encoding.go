@@ -0,0 +1,102 @@
+package graph
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// compareNodes orders nodes of any comparable type so that encoded graphs come
+// out the same every time. Numbers and strings are compared by value, anything
+// else by its default formatting. Distinct nodes formatted the same, such as 1
+// and "1" of an any node type, are told apart by their type and Go syntax.
+func compareNodes[K comparable](a, b K) int {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if va.Kind() == vb.Kind() {
+		c := 0
+		switch va.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			c = cmp.Compare(va.Int(), vb.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			c = cmp.Compare(va.Uint(), vb.Uint())
+		case reflect.Float32, reflect.Float64:
+			c = cmp.Compare(va.Float(), vb.Float())
+		case reflect.String:
+			c = cmp.Compare(va.String(), vb.String())
+		}
+		if c != 0 {
+			return c
+		}
+	}
+
+	if c := cmp.Compare(fmt.Sprint(a), fmt.Sprint(b)); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(fmt.Sprintf("%T", a), fmt.Sprintf("%T", b)); c != 0 {
+		return c
+	}
+
+	return cmp.Compare(fmt.Sprintf("%#v", a), fmt.Sprintf("%#v", b))
+}
+
+// sortedNodes returns the nodes of the graph in the order of compareNodes.
+func (g *Graph[K]) sortedNodes() []K {
+	nodes := make([]K, 0, len(g.nodes))
+	for node := range g.nodes {
+		nodes = append(nodes, node)
+	}
+	slices.SortFunc(nodes, compareNodes)
+
+	return nodes
+}
+
+// sortedEdges returns every edge of the graph once, as a pair of nodes with
+// the smaller one first, in the order of compareNodes. Parallel edges are
+// returned as many times as they were added.
+func (g *Graph[K]) sortedEdges() [][2]K {
+	// Going by the position of nodes, each edge is seen from the smaller
+	// node only, even if two distinct nodes were to compare equal
+	nodes := g.sortedNodes()
+	position := make(map[K]int, len(nodes))
+	for i, node := range nodes {
+		position[node] = i
+	}
+
+	var edges [][2]K
+	for _, node := range nodes {
+		neighbors := slices.Clone(g.nodes[node])
+		slices.SortFunc(neighbors, func(x, y K) int {
+			return cmp.Compare(position[x], position[y])
+		})
+
+		for i, neighbor := range neighbors {
+			switch {
+			case neighbor == node:
+				// A self-loop shows up twice in the node's own neighbors
+				if i%2 == 0 {
+					edges = append(edges, [2]K{node, neighbor})
+				}
+			case position[node] < position[neighbor]:
+				edges = append(edges, [2]K{node, neighbor})
+			}
+		}
+	}
+
+	return edges
+}
+
+// addReadWeight records the weight of an edge read from an encoded graph. As
+// parallel edges share their weight, it is an error for the edges between the
+// same nodes to be given different weights.
+func addReadWeight[K comparable](weights map[[2]K]float64, a, b K, weight float64) error {
+	for _, edge := range [][2]K{{a, b}, {b, a}} {
+		if other, ok := weights[edge]; ok && other != weight {
+			return fmt.Errorf("parallel edges between node %v and node %v have different weights %v and %v", a, b, other, weight)
+		}
+	}
+
+	weights[[2]K{a, b}] = weight
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package graph
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCompareNodes(t *testing.T) {
+	type label int
+
+	tests := []struct {
+		name string
+		a    any
+		b    any
+		want int
+	}{
+		{
+			name: "Should compare numbers by value",
+			a:    2,
+			b:    10,
+			want: -1,
+		},
+		{
+			name: "Should compare equal nodes as equal",
+			a:    "a",
+			b:    "a",
+			want: 0,
+		},
+		{
+			name: "Should tell apart nodes formatted the same",
+			a:    1,
+			b:    "1",
+			want: -1,
+		},
+		{
+			name: "Should tell apart numbers of the same kind and value",
+			a:    1,
+			b:    label(1),
+			want: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := compareNodes(tt.a, tt.b); got != tt.want {
+				t.Errorf("compareNodes(%#v, %#v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+			if got := compareNodes(tt.b, tt.a); got != -tt.want {
+				t.Errorf("compareNodes(%#v, %#v) = %v, want %v", tt.b, tt.a, got, -tt.want)
+			}
+		})
+	}
+}
+
+func TestGraph_sortedEdges_NodesFormattedTheSame(t *testing.T) {
+	g, err := New(map[any][]any{
+		1:   {"1"},
+		"1": {1},
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	want := [][2]any{{1, "1"}}
+	if got := g.sortedEdges(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Graph.sortedEdges() = %#v, want %#v", got, want)
+	}
+
+	var b strings.Builder
+	if err := g.WriteEdgeList(&b); err != nil {
+		t.Fatalf("Graph.WriteEdgeList() error = %v", err)
+	}
+	if got := b.String(); got != "1 1\n" {
+		t.Errorf("Graph.WriteEdgeList() = %q, want a single edge", got)
+	}
+
+	if diff := Diff(g, g.Clone()); !diff.Empty() {
+		t.Errorf("Diff() = %+v, want no changes", diff)
+	}
+}
@@ -0,0 +1,397 @@
+package graph
+
+import (
+	"fmt"
+	"math"
+	"slices"
+)
+
+// FlowNetwork is a directed graph where every arc has a capacity, the most it
+// can carry from its tail to its head, used to compute maximum flows.
+type FlowNetwork[K comparable] struct {
+	arcs     *Digraph[K]
+	capacity map[K]map[K]float64
+}
+
+// NewFlowNetwork creates an instance of FlowNetwork provided the capacity of
+// every arc going out of each node, while also ensuring every arc points to an
+// existing node and no capacity is negative.
+func NewFlowNetwork[K comparable](capacities map[K]map[K]float64) (*FlowNetwork[K], error) {
+	n := FlowNetwork[K]{
+		arcs:     &Digraph[K]{out: make(map[K][]K), in: make(map[K][]K)},
+		capacity: make(map[K]map[K]float64, len(capacities)),
+	}
+
+	for node := range capacities {
+		_ = n.AddNode(node)
+	}
+
+	for node, arcs := range capacities {
+		for successor, capacity := range arcs {
+			err := n.AddArc(node, successor, capacity)
+			if err != nil {
+				return nil, fmt.Errorf("provided capacities are invalid: %w", err)
+			}
+		}
+	}
+
+	return &n, nil
+}
+
+// FlowNetwork returns a flow network with the nodes of the graph, where every
+// edge becomes a pair of opposite arcs, each with the weight of the edge as
+// its capacity. Parallel edges add up their capacities and self-loops are left
+// out, since they cannot carry flow anywhere.
+func (g *Graph[K]) FlowNetwork() *FlowNetwork[K] {
+	n, _ := NewFlowNetwork(map[K]map[K]float64{})
+	for node := range g.nodes {
+		_ = n.AddNode(node)
+	}
+
+	for node, neighbors := range g.nodes {
+		for _, neighbor := range neighbors {
+			if neighbor == node {
+				continue
+			}
+
+			if _, ok := n.capacity[node][neighbor]; !ok {
+				_ = n.AddArc(node, neighbor, 0)
+			}
+			n.capacity[node][neighbor] += g.weight(node, neighbor)
+		}
+	}
+
+	return n
+}
+
+// AddNode adds a new node without any arcs to the flow network, only returns
+// error if the node already exists.
+func (n *FlowNetwork[K]) AddNode(nodeID K) error {
+	err := n.arcs.AddNode(nodeID, nil)
+	if err != nil {
+		return err
+	}
+
+	n.capacity[nodeID] = make(map[K]float64)
+
+	return nil
+}
+
+// AddArc adds an arc of the given capacity to the flow network going from node
+// `from` to node `to`. The capacity must not be negative, and may be infinite
+// for an arc that can never be cut. If error occurs during the process, the
+// error is returned and no changes are made to the flow network.
+func (n *FlowNetwork[K]) AddArc(from, to K, capacity float64) error {
+	if capacity < 0 || math.IsNaN(capacity) {
+		return fmt.Errorf("invalid capacity %v, expected a non-negative number", capacity)
+	}
+
+	err := n.arcs.AddArc(from, to)
+	if err != nil {
+		return err
+	}
+
+	n.capacity[from][to] = capacity
+
+	return nil
+}
+
+// Capacity returns the capacity of the arc going from node `from` to node
+// `to`.
+func (n *FlowNetwork[K]) Capacity(from, to K) (float64, error) {
+	capacity, ok := n.capacity[from][to]
+	if !ok {
+		return 0, fmt.Errorf("arc from node %v to node %v does not exist", from, to)
+	}
+
+	return capacity, nil
+}
+
+// MaxFlow is a maximum flow from a source node to a sink node of a flow
+// network, along with a minimum cut separating them.
+type MaxFlow[K comparable] struct {
+	// Value is the total amount of flow leaving the source.
+	Value float64
+	// Flow holds the amount of flow carried by every arc of the network,
+	// indexed by the tail and then the head of the arc.
+	Flow map[K]map[K]float64
+	// SourceSide holds the nodes still reachable from the source once the
+	// flow is in place, with the source itself among them.
+	SourceSide NodeSet[K]
+	// SinkSide holds the rest of the nodes, with the sink among them. The arcs
+	// going from the source side to the sink side form a minimum cut, their
+	// capacities add up to Value.
+	SinkSide NodeSet[K]
+}
+
+// EdmondsKarp returns the maximum flow from node `source` to node `sink` using
+// the Edmonds–Karp algorithm, which repeatedly sends flow along the shortest
+// path that still has spare capacity. It takes O(V·E²) time. Returns error if
+// arcs of infinite capacity alone connect the source to the sink.
+func (n *FlowNetwork[K]) EdmondsKarp(source, sink K) (*MaxFlow[K], error) {
+	r, err := n.residual(source, sink)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		// Find the shortest augmenting path, remembering the arc each node was
+		// reached through
+		via := make([]int, len(r.nodes))
+		for i := range via {
+			via[i] = -1
+		}
+
+		queue := []int{r.source}
+		for len(queue) > 0 && via[r.sink] == -1 {
+			node := queue[0]
+			queue = queue[1:]
+
+			for _, arc := range r.adj[node] {
+				head := r.arcs[arc].head
+				if head == r.source || via[head] != -1 || r.spare(arc) <= 0 {
+					continue
+				}
+
+				via[head] = arc
+				queue = append(queue, head)
+			}
+		}
+
+		// No augmenting path is left, so the flow is maximal
+		if via[r.sink] == -1 {
+			return r.result(), nil
+		}
+
+		bottleneck := math.Inf(1)
+		for node := r.sink; node != r.source; node = r.arcs[via[node]^1].head {
+			bottleneck = min(bottleneck, r.spare(via[node]))
+		}
+		if math.IsInf(bottleneck, 1) {
+			return nil, r.unbounded()
+		}
+
+		for node := r.sink; node != r.source; node = r.arcs[via[node]^1].head {
+			r.push(via[node], bottleneck)
+		}
+	}
+}
+
+// Dinic returns the maximum flow from node `source` to node `sink` using
+// Dinic's algorithm, which sends a blocking flow along all the shortest paths
+// with spare capacity at once. It takes O(V²·E) time, and is usually faster
+// than EdmondsKarp on large networks. Returns error if arcs of infinite
+// capacity alone connect the source to the sink.
+func (n *FlowNetwork[K]) Dinic(source, sink K) (*MaxFlow[K], error) {
+	r, err := n.residual(source, sink)
+	if err != nil {
+		return nil, err
+	}
+
+	level := make([]int, len(r.nodes))
+	next := make([]int, len(r.nodes))
+
+	// push sends up to `limit` flow from the node to the sink along arcs that
+	// go one level deeper each, returning how much was sent
+	var push func(node int, limit float64) float64
+	push = func(node int, limit float64) float64 {
+		if node == r.sink {
+			return limit
+		}
+
+		for ; next[node] < len(r.adj[node]); next[node]++ {
+			arc := r.adj[node][next[node]]
+			head := r.arcs[arc].head
+			if level[head] != level[node]+1 || r.spare(arc) <= 0 {
+				continue
+			}
+
+			sent := push(head, min(limit, r.spare(arc)))
+			if sent > 0 {
+				r.push(arc, sent)
+				return sent
+			}
+		}
+
+		return 0
+	}
+
+	for {
+		// Split the nodes into levels by their distance from the source
+		for i := range level {
+			level[i] = -1
+		}
+		level[r.source] = 0
+
+		queue := []int{r.source}
+		for len(queue) > 0 {
+			node := queue[0]
+			queue = queue[1:]
+
+			for _, arc := range r.adj[node] {
+				head := r.arcs[arc].head
+				if level[head] != -1 || r.spare(arc) <= 0 {
+					continue
+				}
+
+				level[head] = level[node] + 1
+				queue = append(queue, head)
+			}
+		}
+
+		// The sink cannot be reached anymore, so the flow is maximal
+		if level[r.sink] == -1 {
+			return r.result(), nil
+		}
+
+		clear(next)
+		for {
+			sent := push(r.source, math.Inf(1))
+			if math.IsInf(sent, 1) {
+				return nil, r.unbounded()
+			}
+			if sent == 0 {
+				break
+			}
+		}
+	}
+}
+
+// residualNetwork is a flow network prepared for the max-flow algorithms, with
+// nodes numbered and every arc stored next to its reverse, so that the reverse
+// of arc i is arc i^1.
+type residualNetwork[K comparable] struct {
+	nodes  []K
+	arcs   []residualArc
+	adj    [][]int
+	source int
+	sink   int
+	// original holds the index of each arc of the flow network
+	original map[K]map[K]int
+}
+
+type residualArc struct {
+	head     int
+	capacity float64
+	flow     float64
+}
+
+func (n *FlowNetwork[K]) residual(source, sink K) (*residualNetwork[K], error) {
+	if _, ok := n.capacity[source]; !ok {
+		return nil, fmt.Errorf("source node %v does not exist in the flow network", source)
+	}
+	if _, ok := n.capacity[sink]; !ok {
+		return nil, fmt.Errorf("sink node %v does not exist in the flow network", sink)
+	}
+	if source == sink {
+		return nil, fmt.Errorf("source and sink are the same node %v", source)
+	}
+
+	r := residualNetwork[K]{
+		nodes:    make([]K, 0, len(n.capacity)),
+		original: make(map[K]map[K]int, len(n.capacity)),
+	}
+
+	index := make(map[K]int, len(n.capacity))
+	for node := range n.capacity {
+		r.nodes = append(r.nodes, node)
+	}
+	slices.SortFunc(r.nodes, compareNodes)
+	for i, node := range r.nodes {
+		index[node] = i
+	}
+
+	r.adj = make([][]int, len(r.nodes))
+	for _, node := range r.nodes {
+		r.original[node] = make(map[K]int, len(n.capacity[node]))
+
+		successors := slices.Clone(n.arcs.out[node])
+		slices.SortFunc(successors, compareNodes)
+
+		for _, successor := range successors {
+			tail, head := index[node], index[successor]
+
+			r.original[node][successor] = len(r.arcs)
+			r.adj[tail] = append(r.adj[tail], len(r.arcs))
+			r.arcs = append(r.arcs, residualArc{head: head, capacity: n.capacity[node][successor]})
+			r.adj[head] = append(r.adj[head], len(r.arcs))
+			r.arcs = append(r.arcs, residualArc{head: tail})
+		}
+	}
+
+	r.source, r.sink = index[source], index[sink]
+
+	return &r, nil
+}
+
+// spare returns how much more flow the residual arc can take.
+func (r *residualNetwork[K]) spare(arc int) float64 {
+	// Infinite capacity stays infinite however much flow the arc carries,
+	// rather than turning into NaN once Inf - Inf is taken
+	if math.IsInf(r.arcs[arc].capacity, 1) {
+		return r.arcs[arc].capacity
+	}
+
+	return r.arcs[arc].capacity - r.arcs[arc].flow
+}
+
+// unbounded returns the error for a source connected to the sink by arcs of
+// infinite capacity only, so that the flow between them has no maximum.
+func (r *residualNetwork[K]) unbounded() error {
+	return fmt.Errorf("flow from node %v to node %v is unbounded, arcs of infinite capacity connect them", r.nodes[r.source], r.nodes[r.sink])
+}
+
+// push sends flow along the residual arc, taking it back from its reverse.
+func (r *residualNetwork[K]) push(arc int, flow float64) {
+	r.arcs[arc].flow += flow
+	r.arcs[arc^1].flow -= flow
+}
+
+// result reads the flow and the minimum cut off a residual network with no
+// augmenting path left.
+func (r *residualNetwork[K]) result() *MaxFlow[K] {
+	flow := MaxFlow[K]{
+		Flow:       make(map[K]map[K]float64, len(r.nodes)),
+		SourceSide: make(NodeSet[K]),
+		SinkSide:   make(NodeSet[K]),
+	}
+
+	for tail, arcs := range r.original {
+		flow.Flow[tail] = make(map[K]float64, len(arcs))
+		for head, arc := range arcs {
+			flow.Flow[tail][head] = r.arcs[arc].flow
+		}
+	}
+
+	for _, arc := range r.adj[r.source] {
+		flow.Value += r.arcs[arc].flow
+	}
+
+	reached := make([]bool, len(r.nodes))
+	reached[r.source] = true
+	queue := []int{r.source}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+
+		for _, arc := range r.adj[node] {
+			head := r.arcs[arc].head
+			if reached[head] || r.spare(arc) <= 0 {
+				continue
+			}
+
+			reached[head] = true
+			queue = append(queue, head)
+		}
+	}
+
+	for i, node := range r.nodes {
+		if reached[i] {
+			flow.SourceSide[node] = struct{}{}
+		} else {
+			flow.SinkSide[node] = struct{}{}
+		}
+	}
+
+	return &flow
+}
@@ -0,0 +1,282 @@
+package graph
+
+import (
+	"maps"
+	"math"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestNewFlowNetwork(t *testing.T) {
+	tests := []struct {
+		name       string
+		capacities map[int]map[int]float64
+		wantErr    bool
+	}{
+		{
+			name: "Should create a flow network",
+			capacities: map[int]map[int]float64{
+				0: {1: 3, 2: 2},
+				1: {2: 1},
+				2: {},
+			},
+		},
+		{
+			name: "Should return error if an arc points to a non-existent node",
+			capacities: map[int]map[int]float64{
+				0: {1: 3},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should return error if a capacity is negative",
+			capacities: map[int]map[int]float64{
+				0: {1: -1},
+				1: {},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewFlowNetwork(tt.capacities)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewFlowNetwork() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if !reflect.DeepEqual(got.capacity, tt.capacities) {
+				t.Errorf("NewFlowNetwork() capacities = %v, want %v", got.capacity, tt.capacities)
+			}
+		})
+	}
+}
+
+func TestGraph_FlowNetwork(t *testing.T) {
+	g := IntGraph{
+		nodes: map[int][]int{
+			0: {0, 0, 1, 1, 2},
+			1: {0, 0},
+			2: {0},
+		},
+		weights: map[int]map[int]float64{
+			0: {2: 4},
+			2: {0: 4},
+		},
+		multiEdges: true,
+		selfLoops:  true,
+	}
+
+	got := g.FlowNetwork()
+
+	want := map[int]map[int]float64{
+		0: {1: 2, 2: 4},
+		1: {0: 2},
+		2: {0: 4},
+	}
+	if !reflect.DeepEqual(got.capacity, want) {
+		t.Errorf("Graph.FlowNetwork() capacities = %v, want %v", got.capacity, want)
+	}
+}
+
+func TestFlowNetwork_MaxFlow(t *testing.T) {
+	type args struct {
+		source int
+		sink   int
+	}
+	tests := []struct {
+		name           string
+		capacities     map[int]map[int]float64
+		args           args
+		want           float64
+		wantSourceSide []int
+		wantErr        bool
+	}{
+		{
+			name: "Should find the maximum flow",
+			capacities: map[int]map[int]float64{
+				0: {1: 16, 2: 13},
+				1: {3: 12},
+				2: {1: 4, 4: 14},
+				3: {2: 9, 5: 20},
+				4: {3: 7, 5: 4},
+				5: {},
+			},
+			args:           args{source: 0, sink: 5},
+			want:           23,
+			wantSourceSide: []int{0, 1, 2, 4},
+		},
+		{
+			name: "Should send flow back along an arc when it pays off",
+			capacities: map[int]map[int]float64{
+				0: {1: 1, 2: 1},
+				1: {2: 1, 3: 1},
+				2: {3: 1},
+				3: {},
+			},
+			args:           args{source: 0, sink: 3},
+			want:           2,
+			wantSourceSide: []int{0},
+		},
+		{
+			name: "Should handle arcs going both ways between nodes",
+			capacities: map[int]map[int]float64{
+				0: {1: 2.5},
+				1: {0: 1, 2: 1.5},
+				2: {1: 4},
+			},
+			args:           args{source: 0, sink: 2},
+			want:           1.5,
+			wantSourceSide: []int{0, 1},
+		},
+		{
+			name: "Should return a zero flow if the sink is unreachable",
+			capacities: map[int]map[int]float64{
+				0: {1: 5},
+				1: {},
+				2: {1: 5},
+			},
+			args:           args{source: 0, sink: 2},
+			want:           0,
+			wantSourceSide: []int{0, 1},
+		},
+		{
+			name: "Should return error if source node does not exist",
+			capacities: map[int]map[int]float64{
+				0: {1: 1},
+				1: {},
+			},
+			args:    args{source: 9, sink: 1},
+			wantErr: true,
+		},
+		{
+			name: "Should return error if sink node does not exist",
+			capacities: map[int]map[int]float64{
+				0: {1: 1},
+				1: {},
+			},
+			args:    args{source: 0, sink: 9},
+			wantErr: true,
+		},
+		{
+			name: "Should never cut an arc of infinite capacity",
+			capacities: map[int]map[int]float64{
+				0: {1: math.Inf(1), 2: 2},
+				1: {3: 3},
+				2: {3: math.Inf(1)},
+				3: {},
+			},
+			args:           args{source: 0, sink: 3},
+			want:           5,
+			wantSourceSide: []int{0, 1},
+		},
+		{
+			name: "Should return error if the flow is unbounded",
+			capacities: map[int]map[int]float64{
+				0: {1: math.Inf(1), 2: 1},
+				1: {2: math.Inf(1)},
+				2: {},
+			},
+			args:    args{source: 0, sink: 2},
+			wantErr: true,
+		},
+		{
+			name: "Should return error if source and sink are the same",
+			capacities: map[int]map[int]float64{
+				0: {1: 1},
+				1: {},
+			},
+			args:    args{source: 1, sink: 1},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		n, err := NewFlowNetwork(tt.capacities)
+		if err != nil {
+			t.Fatalf("NewFlowNetwork() error = %v", err)
+		}
+
+		algorithms := map[string]func(source, sink int) (*MaxFlow[int], error){
+			"EdmondsKarp": n.EdmondsKarp,
+			"Dinic":       n.Dinic,
+		}
+		for algorithm, maxFlow := range algorithms {
+			t.Run(tt.name+" with "+algorithm, func(t *testing.T) {
+				got, err := maxFlow(tt.args.source, tt.args.sink)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("FlowNetwork.%v() error = %v, wantErr %v", algorithm, err, tt.wantErr)
+					return
+				}
+				if err != nil {
+					return
+				}
+
+				if got.Value != tt.want {
+					t.Errorf("FlowNetwork.%v() value = %v, want %v", algorithm, got.Value, tt.want)
+				}
+
+				sourceSide := slices.Sorted(maps.Keys(got.SourceSide))
+				if !reflect.DeepEqual(sourceSide, tt.wantSourceSide) {
+					t.Errorf("FlowNetwork.%v() source side = %v, want %v", algorithm, sourceSide, tt.wantSourceSide)
+				}
+				if len(got.SourceSide)+len(got.SinkSide) != len(tt.capacities) {
+					t.Errorf("FlowNetwork.%v() sides = %v and %v, want a partition of every node", algorithm, got.SourceSide, got.SinkSide)
+				}
+
+				// Every arc carries flow within its capacity, every node other
+				// than the ends passes on all the flow it gets, and the arcs
+				// crossing the cut are saturated
+				balance := make(map[int]float64)
+				cut := 0.0
+				for tail, arcs := range tt.capacities {
+					for head, capacity := range arcs {
+						flow := got.Flow[tail][head]
+						if flow < 0 || flow > capacity {
+							t.Errorf("FlowNetwork.%v() flow from %v to %v = %v, want within [0, %v]", algorithm, tail, head, flow, capacity)
+						}
+						balance[tail] -= flow
+						balance[head] += flow
+
+						if got.SourceSide.Contains(tail) && got.SinkSide.Contains(head) {
+							cut += capacity
+						}
+					}
+				}
+				for node, excess := range balance {
+					if node != tt.args.source && node != tt.args.sink && excess != 0 {
+						t.Errorf("FlowNetwork.%v() excess at node %v = %v, want 0", algorithm, node, excess)
+					}
+				}
+				if balance[tt.args.sink] != tt.want {
+					t.Errorf("FlowNetwork.%v() flow into the sink = %v, want %v", algorithm, balance[tt.args.sink], tt.want)
+				}
+				if cut != tt.want {
+					t.Errorf("FlowNetwork.%v() cut capacity = %v, want %v", algorithm, cut, tt.want)
+				}
+			})
+		}
+	}
+}
+
+func TestGraph_FlowNetworkInfiniteWeight(t *testing.T) {
+	g, _ := New(map[string][]string{"s": {}, "a": {}, "t": {}})
+	_ = g.AddWeightedEdge("s", "a", math.Inf(1))
+	_ = g.AddWeightedEdge("a", "t", 4)
+
+	n := g.FlowNetwork()
+	for algorithm, maxFlow := range map[string]func(source, sink string) (*MaxFlow[string], error){
+		"EdmondsKarp": n.EdmondsKarp,
+		"Dinic":       n.Dinic,
+	} {
+		got, err := maxFlow("s", "t")
+		if err != nil {
+			t.Fatalf("FlowNetwork.%v() error = %v", algorithm, err)
+		}
+		if got.Value != 4 {
+			t.Errorf("FlowNetwork.%v() value = %v, want 4", algorithm, got.Value)
+		}
+	}
+}
@@ -0,0 +1,241 @@
+package graph
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+)
+
+// NewComplete creates a graph of `n` nodes numbered from 0 where every node is
+// connected to every other node.
+func NewComplete(n int) (*Graph[int], error) {
+	nodes, err := generatorNodes(n)
+	if err != nil {
+		return nil, err
+	}
+
+	for a := range n {
+		for b := a + 1; b < n; b++ {
+			connect(nodes, a, b)
+		}
+	}
+
+	return New(nodes)
+}
+
+// NewCycle creates a graph of `n` nodes numbered from 0 connected in a ring,
+// each node to the next one and the last one back to the first. There must be
+// at least 3 nodes.
+func NewCycle(n int) (*Graph[int], error) {
+	if n < 3 {
+		return nil, fmt.Errorf("invalid number of nodes %v, a cycle needs at least 3", n)
+	}
+
+	nodes, _ := generatorNodes(n)
+	for a := range n {
+		connect(nodes, a, (a+1)%n)
+	}
+
+	return New(nodes)
+}
+
+// NewPath creates a graph of `n` nodes numbered from 0 connected in a line,
+// each node to the next one.
+func NewPath(n int) (*Graph[int], error) {
+	nodes, err := generatorNodes(n)
+	if err != nil {
+		return nil, err
+	}
+
+	for a := 1; a < n; a++ {
+		connect(nodes, a-1, a)
+	}
+
+	return New(nodes)
+}
+
+// NewStar creates a graph of `n` nodes numbered from 0 where node 0 is
+// connected to every other node and no other edges exist. There must be at
+// least 1 node.
+func NewStar(n int) (*Graph[int], error) {
+	if n < 1 {
+		return nil, fmt.Errorf("invalid number of nodes %v, a star needs at least 1", n)
+	}
+
+	nodes, _ := generatorNodes(n)
+	for leaf := 1; leaf < n; leaf++ {
+		connect(nodes, 0, leaf)
+	}
+
+	return New(nodes)
+}
+
+// NewGrid creates a graph of width × height nodes laid out in rows, where node
+// y*width+x is connected to the nodes next to it horizontally and vertically.
+func NewGrid(width, height int) (*Graph[int], error) {
+	if width < 0 || height < 0 {
+		return nil, fmt.Errorf("invalid grid size %vx%v, expected non-negative dimensions", width, height)
+	}
+
+	nodes, _ := generatorNodes(width * height)
+	for y := range height {
+		for x := range width {
+			node := y*width + x
+			if x+1 < width {
+				connect(nodes, node, node+1)
+			}
+			if y+1 < height {
+				connect(nodes, node, node+width)
+			}
+		}
+	}
+
+	return New(nodes)
+}
+
+// NewErdosRenyi creates a random graph of `n` nodes numbered from 0 where each
+// pair of nodes is connected with probability `p`, independently of the other
+// pairs. The same seed always gives the same graph.
+func NewErdosRenyi(n int, p float64, seed uint64) (*Graph[int], error) {
+	if !(p >= 0 && p <= 1) {
+		return nil, fmt.Errorf("invalid probability %v, expected a number between 0 and 1", p)
+	}
+
+	nodes, err := generatorNodes(n)
+	if err != nil {
+		return nil, err
+	}
+
+	r := rand.New(rand.NewPCG(seed, seed))
+	for a := range n {
+		for b := a + 1; b < n; b++ {
+			if r.Float64() < p {
+				connect(nodes, a, b)
+			}
+		}
+	}
+
+	return New(nodes)
+}
+
+// NewBarabasiAlbert creates a random scale-free graph of `n` nodes numbered
+// from 0 by preferential attachment. It starts from a complete graph of m+1
+// nodes, then connects every further node to `m` distinct earlier nodes,
+// picking each with a probability proportional to its degree. It needs
+// 1 <= m < n. The same seed always gives the same graph.
+func NewBarabasiAlbert(n, m int, seed uint64) (*Graph[int], error) {
+	if m < 1 || m >= n {
+		return nil, fmt.Errorf("invalid number of edges per node %v, expected between 1 and %v", m, n-1)
+	}
+
+	nodes, _ := generatorNodes(n)
+
+	// Every node shows up here once for each of its edges, so picking a
+	// uniformly random element picks a node proportionally to its degree
+	var ends []int
+	for a := 0; a <= m; a++ {
+		for b := a + 1; b <= m; b++ {
+			connect(nodes, a, b)
+			ends = append(ends, a, b)
+		}
+	}
+
+	r := rand.New(rand.NewPCG(seed, seed))
+	for node := m + 1; node < n; node++ {
+		targets := make([]int, 0, m)
+		for len(targets) < m {
+			target := ends[r.IntN(len(ends))]
+			if !slices.Contains(targets, target) {
+				targets = append(targets, target)
+			}
+		}
+
+		// Connect in ascending order, so the neighbors of every node are
+		// listed the same way whatever order the targets were picked in
+		slices.Sort(targets)
+		for _, target := range targets {
+			connect(nodes, node, target)
+			ends = append(ends, node, target)
+		}
+	}
+
+	return New(nodes)
+}
+
+// NewRandomTree creates a random tree of `n` nodes numbered from 0, picked
+// uniformly among all the trees on those nodes by decoding a random Prüfer
+// sequence. There must be at least 1 node. The same seed always gives the
+// same tree.
+func NewRandomTree(n int, seed uint64) (*Graph[int], error) {
+	if n < 1 {
+		return nil, fmt.Errorf("invalid number of nodes %v, a tree needs at least 1", n)
+	}
+
+	nodes, _ := generatorNodes(n)
+	if n == 1 {
+		return New(nodes)
+	}
+
+	r := rand.New(rand.NewPCG(seed, seed))
+	sequence := make([]int, n-2)
+	degree := make([]int, n)
+	for i := range degree {
+		degree[i] = 1
+	}
+	for i := range sequence {
+		sequence[i] = r.IntN(n)
+		degree[sequence[i]]++
+	}
+
+	// Every node of the sequence is connected to the smallest leaf left,
+	// which is then removed from the tree. The smallest leaf only moves back
+	// when removing a leaf turns its neighbor into a smaller leaf, so the
+	// search for the next one resumes from where it left off.
+	next := 0
+	for degree[next] != 1 {
+		next++
+	}
+	leaf := next
+	for _, node := range sequence {
+		connect(nodes, node, leaf)
+		degree[leaf]--
+		degree[node]--
+
+		if degree[node] == 1 && node < next {
+			leaf = node
+			continue
+		}
+
+		next++
+		for degree[next] != 1 {
+			next++
+		}
+		leaf = next
+	}
+
+	// The last leaf is connected to the largest node, always the last one left
+	connect(nodes, leaf, n-1)
+
+	return New(nodes)
+}
+
+// generatorNodes returns the adjacency of `n` nodes numbered from 0 without
+// any edges.
+func generatorNodes(n int) (map[int][]int, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("invalid number of nodes %v, expected a non-negative number", n)
+	}
+
+	nodes := make(map[int][]int, n)
+	for node := range n {
+		nodes[node] = []int{}
+	}
+
+	return nodes, nil
+}
+
+// connect adds an edge between node `a` and node `b` to the adjacency.
+func connect(nodes map[int][]int, a, b int) {
+	nodes[a] = append(nodes[a], b)
+	nodes[b] = append(nodes[b], a)
+}
@@ -0,0 +1,200 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewGenerated(t *testing.T) {
+	tests := []struct {
+		name     string
+		generate func() (*Graph[int], error)
+		want     map[int][]int
+		wantErr  bool
+	}{
+		{
+			name:     "Should create a complete graph",
+			generate: func() (*Graph[int], error) { return NewComplete(4) },
+			want: map[int][]int{
+				0: {1, 2, 3},
+				1: {0, 2, 3},
+				2: {0, 1, 3},
+				3: {0, 1, 2},
+			},
+		},
+		{
+			name:     "Should create a cycle",
+			generate: func() (*Graph[int], error) { return NewCycle(4) },
+			want: map[int][]int{
+				0: {1, 3},
+				1: {0, 2},
+				2: {1, 3},
+				3: {0, 2},
+			},
+		},
+		{
+			name:     "Should create a path",
+			generate: func() (*Graph[int], error) { return NewPath(3) },
+			want: map[int][]int{
+				0: {1},
+				1: {0, 2},
+				2: {1},
+			},
+		},
+		{
+			name:     "Should create a star",
+			generate: func() (*Graph[int], error) { return NewStar(4) },
+			want: map[int][]int{
+				0: {1, 2, 3},
+				1: {0},
+				2: {0},
+				3: {0},
+			},
+		},
+		{
+			name:     "Should create a grid",
+			generate: func() (*Graph[int], error) { return NewGrid(3, 2) },
+			want: map[int][]int{
+				0: {1, 3},
+				1: {0, 2, 4},
+				2: {1, 5},
+				3: {0, 4},
+				4: {1, 3, 5},
+				5: {2, 4},
+			},
+		},
+		{
+			name:     "Should create an empty graph",
+			generate: func() (*Graph[int], error) { return NewComplete(0) },
+			want:     map[int][]int{},
+		},
+		{
+			name:     "Should return error for a negative number of nodes",
+			generate: func() (*Graph[int], error) { return NewPath(-1) },
+			wantErr:  true,
+		},
+		{
+			name:     "Should return error for a cycle of two nodes",
+			generate: func() (*Graph[int], error) { return NewCycle(2) },
+			wantErr:  true,
+		},
+		{
+			name:     "Should return error for a star without nodes",
+			generate: func() (*Graph[int], error) { return NewStar(0) },
+			wantErr:  true,
+		},
+		{
+			name:     "Should return error for a negative grid size",
+			generate: func() (*Graph[int], error) { return NewGrid(3, -2) },
+			wantErr:  true,
+		},
+		{
+			name:     "Should return error for an invalid probability",
+			generate: func() (*Graph[int], error) { return NewErdosRenyi(5, 1.5, 1) },
+			wantErr:  true,
+		},
+		{
+			name:     "Should return error for too many edges per node",
+			generate: func() (*Graph[int], error) { return NewBarabasiAlbert(3, 3, 1) },
+			wantErr:  true,
+		},
+		{
+			name:     "Should return error for a tree without nodes",
+			generate: func() (*Graph[int], error) { return NewRandomTree(0, 1) },
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.generate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("generator error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+
+			sortAdjacency(got.nodes)
+			if !reflect.DeepEqual(got.nodes, tt.want) {
+				t.Errorf("generator Graph.nodes = %v, want %v", got.nodes, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewRandomGenerated(t *testing.T) {
+	tests := []struct {
+		name      string
+		generate  func(seed uint64) (*Graph[int], error)
+		wantNodes int
+		// wantEdges is the exact number of edges, or -1 if it is random
+		wantEdges     int
+		wantConnected bool
+		wantAcyclic   bool
+	}{
+		{
+			name:      "Should create an Erdős–Rényi graph",
+			generate:  func(seed uint64) (*Graph[int], error) { return NewErdosRenyi(50, 0.1, seed) },
+			wantNodes: 50,
+			wantEdges: -1,
+		},
+		{
+			name:      "Should create an Erdős–Rényi graph with every edge",
+			generate:  func(seed uint64) (*Graph[int], error) { return NewErdosRenyi(10, 1, seed) },
+			wantNodes: 10,
+			wantEdges: 45,
+		},
+		{
+			name:          "Should create a Barabási–Albert graph",
+			generate:      func(seed uint64) (*Graph[int], error) { return NewBarabasiAlbert(50, 3, seed) },
+			wantNodes:     50,
+			wantEdges:     6 + 46*3,
+			wantConnected: true,
+		},
+		{
+			name:          "Should create a random tree",
+			generate:      func(seed uint64) (*Graph[int], error) { return NewRandomTree(50, seed) },
+			wantNodes:     50,
+			wantEdges:     49,
+			wantConnected: true,
+			wantAcyclic:   true,
+		},
+		{
+			name:          "Should create a random tree of a single node",
+			generate:      func(seed uint64) (*Graph[int], error) { return NewRandomTree(1, seed) },
+			wantNodes:     1,
+			wantEdges:     0,
+			wantConnected: true,
+			wantAcyclic:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for seed := range uint64(20) {
+				got, err := tt.generate(seed)
+				if err != nil {
+					t.Fatalf("generator error = %v", err)
+				}
+
+				again, _ := tt.generate(seed)
+				if !reflect.DeepEqual(got.nodes, again.nodes) {
+					t.Fatalf("generator with seed %v gave %v, then %v", seed, got.nodes, again.nodes)
+				}
+
+				if len(got.nodes) != tt.wantNodes {
+					t.Errorf("generator has %v nodes, want %v", len(got.nodes), tt.wantNodes)
+				}
+				if edges := len(got.sortedEdges()); tt.wantEdges != -1 && edges != tt.wantEdges {
+					t.Errorf("generator has %v edges, want %v", edges, tt.wantEdges)
+				}
+				if tt.wantConnected && len(got.ConnectedComponents()) != 1 {
+					t.Errorf("generator with seed %v gave a disconnected graph %v", seed, got.nodes)
+				}
+				if tt.wantAcyclic && got.CycleExists() {
+					t.Errorf("generator with seed %v gave a graph with a cycle %v", seed, got.nodes)
+				}
+			}
+		})
+	}
+}
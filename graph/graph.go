@@ -2,50 +2,142 @@ package graph
 
 import (
 	"fmt"
+	"math"
 	"slices"
 )
 
-type Graph struct {
-	nodes map[int][]int
+// Graph is an undirected graph whose nodes are identified by any comparable
+// key K, e.g. ints, strings or small structs.
+type Graph[K comparable] struct {
+	nodes map[K][]K
+	// weights holds the weight of every weighted edge in both directions, edges
+	// missing from it weigh 1.
+	weights map[K]map[K]float64
+	// nodeAttrs and edgeAttrs hold attributes of nodes and edges, the latter
+	// in both directions the same way weights are.
+	nodeAttrs map[K]map[string]any
+	edgeAttrs map[K]map[K]map[string]any
+	// components indexes connected components, it is built by New and kept
+	// up to date as nodes and edges are added and removed.
+	components *componentIndex[K]
+
+	// multiEdges and selfLoops hold the edge policy chosen in New.
+	multiEdges bool
+	selfLoops  bool
+	// edgeIDs lists the ids of the parallel edges between two nodes in both
+	// directions, and edgeEnds maps every id back to its nodes. Only
+	// multigraphs track edge ids.
+	edgeIDs    map[K]map[K][]EdgeID
+	edgeEnds   map[EdgeID]Edge[K]
+	nextEdgeID EdgeID
 }
 
+// IntGraph is a Graph identified by int nodes, kept for the code written
+// before Graph became generic.
+type IntGraph = Graph[int]
+
 // New creates an instance of Graph provided nodes, while also ensuring their
-// relations are mutual and valid.
-func New(nodes map[int][]int) (*Graph, error) {
-	g := Graph{
-		nodes: nodes,
+// relations are mutual and valid. The nodes are copied, so later changes to
+// the map do not affect the graph and vice versa. One-way relations belong in
+// a Digraph, see NewDigraph.
+//
+// By default the graph is simple, neither parallel edges nor self-loops are
+// allowed, which can be changed with WithMultiEdges and WithSelfLoops. A
+// self-loop is listed twice among its node's neighbors, once for each end.
+func New[K comparable](nodes map[K][]K, opts ...Option) (*Graph[K], error) {
+	var options graphOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	g := Graph[K]{
+		nodes:      cloneAdjacency(nodes),
+		multiEdges: options.multiEdges,
+		selfLoops:  options.selfLoops,
 	}
 
 	// Check that each node's relation is mutual
 	for node, neighbors := range g.nodes {
 		for _, neighbor := range neighbors {
 			if !slices.Contains(g.nodes[neighbor], node) {
-				return nil, fmt.Errorf("provided nodes are invalid, expected node %d to contain node %d", neighbor, node)
+				return nil, fmt.Errorf("provided nodes are invalid, expected node %v to contain node %v", neighbor, node)
+			}
+		}
+	}
+
+	// Check that edges are listed as many times on each side and follow the
+	// edge policy
+	for node, neighbors := range g.nodes {
+		multiplicity := make(map[K]int, len(neighbors))
+		for _, neighbor := range neighbors {
+			multiplicity[neighbor]++
+		}
+
+		for neighbor, count := range multiplicity {
+			if neighbor == node {
+				if !g.selfLoops {
+					return nil, fmt.Errorf("provided nodes are invalid, self-loop on node %v is not allowed", node)
+				}
+				if count%2 != 0 {
+					return nil, fmt.Errorf("provided nodes are invalid, expected self-loop on node %v to be listed twice", node)
+				}
+				count /= 2
+			} else if other := countOf(g.nodes[neighbor], node); other != count {
+				return nil, fmt.Errorf("provided nodes are invalid, node %v lists node %v %d times, but node %v lists node %v %d times", node, neighbor, count, neighbor, node, other)
+			}
+
+			if count > 1 && !g.multiEdges {
+				return nil, fmt.Errorf("provided nodes are invalid, parallel edges between node %v and node %v are not allowed", node, neighbor)
 			}
 		}
 	}
 
+	if g.multiEdges {
+		for _, edge := range g.sortedEdges() {
+			g.assignEdgeID(edge[0], edge[1])
+		}
+	}
+
+	g.rebuildComponents()
+
 	return &g, nil
 }
 
 // AddNode adds a new node to the graph provided its id and connections, if
 // error occurs during the process, the error is returned and no changes are
 // made to the graph.
-func (g *Graph) AddNode(nodeID int, connections []int) error {
+func (g *Graph[K]) AddNode(nodeID K, connections []K) error {
 	if g.nodes[nodeID] != nil {
-		return fmt.Errorf("node %d already exists", nodeID)
+		return fmt.Errorf("node %v already exists", nodeID)
 	}
 
-	for _, connection := range connections {
+	for i, connection := range connections {
 		if g.nodes[connection] == nil {
-			return fmt.Errorf("unable to connect new node to non-existent node %d", connection)
+			return fmt.Errorf("unable to connect new node to non-existent node %v", connection)
+		}
+		if !g.multiEdges && slices.Contains(connections[:i], connection) {
+			return fmt.Errorf("parallel edges between node %v and node %v are not allowed", nodeID, connection)
 		}
 	}
 
-	g.nodes[nodeID] = connections
+	g.nodes[nodeID] = slices.Clone(connections)
+	if g.nodes[nodeID] == nil {
+		g.nodes[nodeID] = []K{}
+	}
 
 	for _, connection := range connections {
 		g.nodes[connection] = append(g.nodes[connection], nodeID)
+
+		if g.multiEdges {
+			g.assignEdgeID(nodeID, connection)
+		}
+	}
+
+	if g.components != nil {
+		g.components.add(nodeID)
+		for _, connection := range connections {
+			g.components.union(nodeID, connection)
+		}
 	}
 
 	return nil
@@ -54,64 +146,167 @@ func (g *Graph) AddNode(nodeID int, connections []int) error {
 // RemoveNode removes a node from the graph provided its id, only returns error
 // if the node already does not exist, indicating that no removing operation was
 // needed to be performed.
-func (g *Graph) RemoveNode(nodeID int) error {
+func (g *Graph[K]) RemoveNode(nodeID K) error {
 	if g.nodes[nodeID] == nil {
-		return fmt.Errorf("node %d does not exist", nodeID)
+		return fmt.Errorf("node %v does not exist", nodeID)
 	}
 
+	for _, neighbor := range g.nodes[nodeID] {
+		g.removeEdgeIDs(nodeID, neighbor)
+	}
+	delete(g.edgeIDs, nodeID)
+
+	for _, neighbor := range g.nodes[nodeID] {
+		delete(g.edgeAttrs[neighbor], nodeID)
+	}
+	delete(g.edgeAttrs, nodeID)
+	delete(g.nodeAttrs, nodeID)
+
+	// Relations are mutual, so only the neighbors refer to the node
+	neighbors := g.nodes[nodeID]
 	delete(g.nodes, nodeID)
+	delete(g.weights, nodeID)
+	for _, neighbor := range neighbors {
+		delete(g.weights[neighbor], nodeID)
 
-	for node := range g.nodes {
-		g.nodes[node] = slices.DeleteFunc(g.nodes[node], func(neighbor int) bool {
-			return neighbor == nodeID
-		})
+		if neighbor != nodeID {
+			g.nodes[neighbor] = slices.DeleteFunc(g.nodes[neighbor], func(other K) bool {
+				return other == nodeID
+			})
+		}
 	}
 
+	g.removeFromComponent(nodeID, neighbors)
+
 	return nil
 }
 
 // AddEdge adds an edge to the graph between node `a` and node `b`, if error
 // occurs during the process, the error is returned and no changes are made to
-// the graph.
-func (g *Graph) AddEdge(a, b int) error {
+// the graph. Unless the graph was created with WithMultiEdges, adding an edge
+// that already exists is an error, and so is adding a self-loop unless it was
+// created with WithSelfLoops. A parallel edge takes the weight of the edges
+// already between the nodes.
+func (g *Graph[K]) AddEdge(a, b K) error {
 	if g.nodes[a] == nil {
-		return fmt.Errorf("node %d does not exist", a)
+		return fmt.Errorf("node %v does not exist", a)
 	}
 	if g.nodes[b] == nil {
-		return fmt.Errorf("node %d does not exist", b)
+		return fmt.Errorf("node %v does not exist", b)
+	}
+	if a == b && !g.selfLoops {
+		return fmt.Errorf("self-loop on node %v is not allowed", a)
+	}
+	if !g.multiEdges && slices.Contains(g.nodes[a], b) {
+		return fmt.Errorf("edge between node %v and node %v already exists", a, b)
 	}
 
 	g.nodes[a] = append(g.nodes[a], b)
 	g.nodes[b] = append(g.nodes[b], a)
 
+	if g.multiEdges {
+		g.assignEdgeID(a, b)
+	}
+
+	if g.components != nil {
+		g.components.union(a, b)
+	}
+
+	return nil
+}
+
+// AddWeightedEdge adds an edge of the given weight to the graph between node
+// `a` and node `b`. The weight must not be negative. If error occurs during the
+// process, the error is returned and no changes are made to the graph. Parallel
+// edges share their weight, so adding a parallel edge of a different weight
+// than the edges already between the nodes is an error.
+func (g *Graph[K]) AddWeightedEdge(a, b K, weight float64) error {
+	if weight < 0 || math.IsNaN(weight) {
+		return fmt.Errorf("invalid weight %v, expected a non-negative number", weight)
+	}
+	if current := g.weight(a, b); g.multiEdges && slices.Contains(g.nodes[a], b) && current != weight {
+		return fmt.Errorf("parallel edges between node %v and node %v weigh %v, unable to add one of weight %v", a, b, current, weight)
+	}
+
+	err := g.AddEdge(a, b)
+	if err != nil {
+		return err
+	}
+
+	g.setWeight(a, b, weight)
+	g.setWeight(b, a, weight)
+
 	return nil
 }
 
+// Weight returns the weight of the edge between node `a` and node `b`. Edges
+// added without a weight weigh 1.
+func (g *Graph[K]) Weight(a, b K) (float64, error) {
+	if !slices.Contains(g.nodes[a], b) {
+		return 0, fmt.Errorf("connection between node %v and node %v does not exist", a, b)
+	}
+
+	return g.weight(a, b), nil
+}
+
+func (g *Graph[K]) weight(a, b K) float64 {
+	weight, ok := g.weights[a][b]
+	if !ok {
+		return 1
+	}
+
+	return weight
+}
+
+func (g *Graph[K]) setWeight(a, b K, weight float64) {
+	if g.weights == nil {
+		g.weights = make(map[K]map[K]float64)
+	}
+	if g.weights[a] == nil {
+		g.weights[a] = make(map[K]float64)
+	}
+
+	g.weights[a][b] = weight
+}
+
 // RemoveEdge removes an edge from the graph provided node `a` and node `b`,
 // only returns error if the edge already does not exist, indicating that no
-// removing operation was needed to be performed.
-func (g *Graph) RemoveEdge(a, b int) error {
+// removing operation was needed to be performed. In a multigraph every
+// parallel edge between the nodes is removed, see RemoveEdgeByID to remove
+// just one of them.
+func (g *Graph[K]) RemoveEdge(a, b K) error {
 	if g.nodes[a] == nil {
-		return fmt.Errorf("node %d does not exist", a)
+		return fmt.Errorf("node %v does not exist", a)
 	}
 	if g.nodes[b] == nil {
-		return fmt.Errorf("node %d does not exist", b)
+		return fmt.Errorf("node %v does not exist", b)
 	}
 	if !slices.Contains(g.nodes[a], b) || !slices.Contains(g.nodes[b], a) {
-		return fmt.Errorf("connection between node %d and node %d does not exist", a, b)
+		return fmt.Errorf("connection between node %v and node %v does not exist", a, b)
 	}
 
-	g.nodes[a] = slices.DeleteFunc(g.nodes[a], func(neighbor int) bool {
+	g.nodes[a] = slices.DeleteFunc(g.nodes[a], func(neighbor K) bool {
 		return neighbor == b
 	})
 
-	g.nodes[b] = slices.DeleteFunc(g.nodes[b], func(neighbor int) bool {
+	g.nodes[b] = slices.DeleteFunc(g.nodes[b], func(neighbor K) bool {
 		return neighbor == a
 	})
 
+	delete(g.weights[a], b)
+	delete(g.weights[b], a)
+	g.removeEdgeAttrs(a, b)
+	g.removeEdgeIDs(a, b)
+	g.splitComponent(a, b)
+
 	return nil
 }
 
+// LookupNode is a node reached by a path search along with the node it was
+// reached from.
+//
+// Deprecated: FindShortestPath no longer uses it and it is kept only for
+// compatibility.
 type LookupNode struct {
 	ID     int
 	Parent *LookupNode
@@ -119,89 +314,185 @@ type LookupNode struct {
 
 // FindShortestPath returns the shortest path between node `a` and node `b`. If
 // multiple same length paths exist, it will return one of them without any
-// defined logic.
-func (g *Graph) FindShortestPath(a, b int) ([]int, error) {
-	if g.nodes[b] == nil {
-		return nil, fmt.Errorf("end node %d does not exist in the graph", b)
+// defined logic, use FindShortestPathFunc to get the same one every time. The
+// path always leaves node `a`, so a path from a node to itself does not exist.
+func (g *Graph[K]) FindShortestPath(a, b K) ([]K, error) {
+	if _, ok := g.nodes[a]; !ok {
+		return nil, fmt.Errorf("start node %v does not exist in the graph", a)
+	}
+	if _, ok := g.nodes[b]; !ok {
+		return nil, fmt.Errorf("end node %v does not exist in the graph", b)
+	}
+
+	// Every node is queued once, remembering the node it was reached from.
+	// The start node counts as seen from the beginning, so it is never a
+	// target and a path from a node to itself does not exist.
+	prev := map[K]K{a: a}
+	queue := []K{a}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+
+		for _, neighbor := range g.nodes[node] {
+			if _, seen := prev[neighbor]; seen {
+				continue
+			}
+			prev[neighbor] = node
+
+			// Reached the target
+			if neighbor == b {
+				return pathTo(prev, a, b), nil
+			}
+
+			queue = append(queue, neighbor)
+		}
+	}
+
+	return nil, fmt.Errorf("a path between node %v and node %v does not exist", a, b)
+}
+
+// pathTo follows the `prev` links back from node `b` to node `a` and returns
+// the nodes passed in order from `a` to `b`.
+func pathTo[K comparable](prev map[K]K, a, b K) []K {
+	path := []K{b}
+	for node := b; node != a; {
+		node = prev[node]
+		path = append(path, node)
+	}
+
+	slices.Reverse(path)
+
+	return path
+}
+
+// FindShortestPathFunc returns the shortest path between node `a` and node
+// `b`. If multiple same length paths exist, it returns the lexicographically
+// smallest one, comparing nodes with `cmp`, so the result does not depend on
+// the order edges were added in. Like FindShortestPath, it finds no path from
+// a node to itself.
+func (g *Graph[K]) FindShortestPathFunc(a, b K, cmp func(x, y K) int) ([]K, error) {
+	if _, ok := g.nodes[a]; !ok {
+		return nil, fmt.Errorf("start node %v does not exist in the graph", a)
 	}
 
-	lookupNodes := []LookupNode{
-		{
-			ID:     a,
-			Parent: nil,
-		},
+	// Distances to the end node tell which neighbors lead along a shortest
+	// path, then the smallest of them is picked at every step
+	dist, err := g.distancesFrom(b)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := dist[a]; !ok || a == b {
+		return nil, fmt.Errorf("a path between node %v and node %v does not exist", a, b)
 	}
 
-	pointer := 0
-	for {
-		// If there are no more nodes to check, we've reached the dead end
-		if len(lookupNodes) <= pointer {
-			return nil, fmt.Errorf("a path between node %d and node %d does not exist", a, b)
+	path := []K{a}
+	for node := a; node != b; {
+		var next K
+		found := false
+		for _, neighbor := range g.nodes[node] {
+			if d, ok := dist[neighbor]; !ok || d != dist[node]-1 {
+				continue
+			}
+			if !found || cmp(neighbor, next) < 0 {
+				next = neighbor
+				found = true
+			}
 		}
 
-		node := lookupNodes[pointer]
+		node = next
+		path = append(path, node)
+	}
+
+	return path, nil
+}
+
+// AllShortestPaths returns every shortest path between node `a` and node `b`
+// in no particular order. The number of such paths can grow exponentially with
+// the size of the graph. Like FindShortestPath, it finds no path from a node to
+// itself.
+func (g *Graph[K]) AllShortestPaths(a, b K) ([][]K, error) {
+	if _, ok := g.nodes[a]; !ok {
+		return nil, fmt.Errorf("start node %v does not exist in the graph", a)
+	}
 
-		neighbors, ok := g.nodes[node.ID]
-		if !ok {
-			return nil, fmt.Errorf("node %d does not exist in the graph", node.ID)
+	dist, err := g.distancesFrom(b)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := dist[a]; !ok || a == b {
+		return nil, fmt.Errorf("a path between node %v and node %v does not exist", a, b)
+	}
+
+	var paths [][]K
+	path := []K{a}
+
+	var walk func(node K)
+	walk = func(node K) {
+		if node == b {
+			paths = append(paths, slices.Clone(path))
+			return
 		}
 
-		for _, neighbor := range neighbors {
-			// This is where we came from
-			if node.Parent != nil && neighbor == node.Parent.ID {
+		var visited []K
+		for _, neighbor := range g.nodes[node] {
+			if d, ok := dist[neighbor]; !ok || d != dist[node]-1 {
 				continue
 			}
 
-			// This is a loop
-			if neighbor == a {
+			// Parallel edges lead to the same path
+			if slices.Contains(visited, neighbor) {
 				continue
 			}
+			visited = append(visited, neighbor)
 
-			// Reached the target
-			if neighbor == b {
-				path := []int{b}
-				for {
-					path = append(path, node.ID)
+			path = append(path, neighbor)
+			walk(neighbor)
+			path = path[:len(path)-1]
+		}
+	}
+	walk(a)
 
-					// Reached the beginning of the path
-					if node.Parent == nil {
-						break
-					}
+	return paths, nil
+}
 
-					// Move to the parent node
-					node = *node.Parent
-				}
+// distancesFrom returns the number of edges on the shortest path from the node
+// to every node reachable from it.
+func (g *Graph[K]) distancesFrom(nodeID K) (map[K]int, error) {
+	if _, ok := g.nodes[nodeID]; !ok {
+		return nil, fmt.Errorf("end node %v does not exist in the graph", nodeID)
+	}
 
-				slices.Reverse(path)
+	dist := map[K]int{nodeID: 0}
+	queue := []K{nodeID}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
 
-				return path, nil
+		for _, neighbor := range g.nodes[node] {
+			if _, ok := dist[neighbor]; ok {
+				continue
 			}
 
-			// Add the node for the lookup
-			lookupNodes = append(lookupNodes, LookupNode{
-				ID:     neighbor,
-				Parent: &lookupNodes[pointer],
-			})
+			dist[neighbor] = dist[node] + 1
+			queue = append(queue, neighbor)
 		}
-
-		// Did not find the target yet, going to the next node
-		pointer++
 	}
+
+	return dist, nil
 }
 
-// PathExists checks whether a path between node `a` and node `b` exist.
-func (g *Graph) PathExists(a, b int) bool {
+// PathExists checks whether a path between node `a` and node `b` exist. A
+// path from a node to itself does not, see FindShortestPath.
+func (g *Graph[K]) PathExists(a, b K) bool {
 	path, err := g.FindShortestPath(a, b)
 	return len(path) > 0 && err == nil
 }
 
-// CycleExists checks whether there is any cycle in the graph.
-func (g *Graph) CycleExists() bool {
-	for node := range g.nodes {
-		if g.PathExists(node, node) {
-			return true
-		}
-	}
-
-	return false
+// CycleExists checks whether there is any cycle in the graph. A cycle goes
+// back to its first node without using any edge twice, so parallel edges form
+// a cycle of their two nodes and a self-loop forms a cycle of a single node,
+// while a single edge between two nodes does not form a cycle.
+func (g *Graph[K]) CycleExists() bool {
+	_, ok := g.FindCycle()
+	return ok
 }
@@ -1,11 +1,61 @@
 package graph
 
 import (
+	"cmp"
 	"reflect"
 	"slices"
 	"testing"
 )
 
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name      string
+		nodes     map[string][]string
+		wantNodes map[string][]string
+		wantErr   bool
+	}{
+		{
+			name: "Should create a graph with string nodes",
+			nodes: map[string][]string{
+				"api":   {"auth", "db"},
+				"auth":  {"api", "db"},
+				"db":    {"api", "auth"},
+				"cache": {},
+			},
+			wantNodes: map[string][]string{
+				"api":   {"auth", "db"},
+				"auth":  {"api", "db"},
+				"db":    {"api", "auth"},
+				"cache": {},
+			},
+		},
+		{
+			name: "Should return error if a relation is not mutual",
+			nodes: map[string][]string{
+				"api":  {"auth", "db"},
+				"auth": {"api"},
+				"db":   {},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := New(tt.nodes)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("New() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got.nodes, tt.wantNodes) {
+				t.Errorf("New() Graph.nodes = %v, want %v", got.nodes, tt.wantNodes)
+			}
+		})
+	}
+}
+
 func TestGraph_AddNode(t *testing.T) {
 	type args struct {
 		node        int
@@ -13,14 +63,14 @@ func TestGraph_AddNode(t *testing.T) {
 	}
 	tests := []struct {
 		name      string
-		graph     Graph
+		graph     IntGraph
 		args      args
 		wantNodes map[int][]int
 		wantErr   bool
 	}{
 		{
 			name: "Should add a node along with all connections",
-			graph: Graph{
+			graph: IntGraph{
 				nodes: map[int][]int{
 					0: {4, 6, 8},
 					1: {2, 5, 8},
@@ -49,7 +99,7 @@ func TestGraph_AddNode(t *testing.T) {
 		},
 		{
 			name: "Should return error if the node already exists",
-			graph: Graph{
+			graph: IntGraph{
 				nodes: map[int][]int{
 					0: {4, 6, 8},
 					1: {2, 5, 8},
@@ -78,7 +128,7 @@ func TestGraph_AddNode(t *testing.T) {
 		},
 		{
 			name: "Should return error if a connection is to a non-existent node",
-			graph: Graph{
+			graph: IntGraph{
 				nodes: map[int][]int{
 					0: {4, 6, 8},
 					1: {2, 5, 8},
@@ -133,14 +183,14 @@ func TestGraph_RemoveNode(t *testing.T) {
 	}
 	tests := []struct {
 		name      string
-		graph     Graph
+		graph     IntGraph
 		args      args
 		wantNodes map[int][]int
 		wantErr   bool
 	}{
 		{
 			name: "Should remove a node and all its edges",
-			graph: Graph{
+			graph: IntGraph{
 				nodes: map[int][]int{
 					0: {4, 6, 8},
 					1: {2, 5, 8},
@@ -168,7 +218,7 @@ func TestGraph_RemoveNode(t *testing.T) {
 		},
 		{
 			name: "Should return error if the does not exist",
-			graph: Graph{
+			graph: IntGraph{
 				nodes: map[int][]int{
 					0: {4, 6, 8},
 					1: {2, 5, 8},
@@ -224,14 +274,14 @@ func TestGraph_AddEdge(t *testing.T) {
 	}
 	tests := []struct {
 		name      string
-		graph     Graph
+		graph     IntGraph
 		args      args
 		wantNodes map[int][]int
 		wantErr   bool
 	}{
 		{
 			name: "Should add an edge between two nodes",
-			graph: Graph{
+			graph: IntGraph{
 				nodes: map[int][]int{
 					0: {4, 6, 8},
 					1: {2, 5, 8},
@@ -260,7 +310,7 @@ func TestGraph_AddEdge(t *testing.T) {
 		},
 		{
 			name: "Should return error if node a does not exist",
-			graph: Graph{
+			graph: IntGraph{
 				nodes: map[int][]int{
 					0: {4, 6, 8},
 					1: {2, 5, 8},
@@ -289,7 +339,7 @@ func TestGraph_AddEdge(t *testing.T) {
 		},
 		{
 			name: "Should return error if node b does not exist",
-			graph: Graph{
+			graph: IntGraph{
 				nodes: map[int][]int{
 					0: {4, 6, 8},
 					1: {2, 5, 8},
@@ -338,6 +388,173 @@ func TestGraph_AddEdge(t *testing.T) {
 	}
 }
 
+func TestGraph_AddWeightedEdge(t *testing.T) {
+	type args struct {
+		a      int
+		b      int
+		weight float64
+	}
+	tests := []struct {
+		name        string
+		graph       IntGraph
+		args        args
+		wantNodes   map[int][]int
+		wantWeights map[int]map[int]float64
+		wantErr     bool
+	}{
+		{
+			name: "Should add a weighted edge between two nodes",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1},
+					1: {0},
+					2: {},
+				},
+			},
+			args: args{a: 0, b: 2, weight: 2.5},
+			wantNodes: map[int][]int{
+				0: {1, 2},
+				1: {0},
+				2: {0},
+			},
+			wantWeights: map[int]map[int]float64{
+				0: {2: 2.5},
+				2: {0: 2.5},
+			},
+		},
+		{
+			name: "Should return error if the weight is negative",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1},
+					1: {0},
+					2: {},
+				},
+			},
+			args: args{a: 0, b: 2, weight: -1},
+			wantNodes: map[int][]int{
+				0: {1},
+				1: {0},
+				2: {},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should return error if the edge already exists",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1},
+					1: {0},
+				},
+			},
+			args: args{a: 0, b: 1, weight: 3},
+			wantNodes: map[int][]int{
+				0: {1},
+				1: {0},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should return error if node b does not exist",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1},
+					1: {0},
+				},
+			},
+			args: args{a: 0, b: 9, weight: 1},
+			wantNodes: map[int][]int{
+				0: {1},
+				1: {0},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.graph.AddWeightedEdge(tt.args.a, tt.args.b, tt.args.weight); (err != nil) != tt.wantErr {
+				t.Errorf("Graph.AddWeightedEdge() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			// Sort before comparing
+			for node := range tt.graph.nodes {
+				slices.Sort(tt.graph.nodes[node])
+			}
+
+			if !reflect.DeepEqual(tt.graph.nodes, tt.wantNodes) {
+				t.Errorf("Graph.AddWeightedEdge() Graph.nodes = %v, want %v", tt.graph.nodes, tt.wantNodes)
+			}
+			if !reflect.DeepEqual(tt.graph.weights, tt.wantWeights) {
+				t.Errorf("Graph.AddWeightedEdge() Graph.weights = %v, want %v", tt.graph.weights, tt.wantWeights)
+			}
+		})
+	}
+}
+
+func TestGraph_Weight(t *testing.T) {
+	type args struct {
+		a int
+		b int
+	}
+	tests := []struct {
+		name    string
+		graph   IntGraph
+		args    args
+		want    float64
+		wantErr bool
+	}{
+		{
+			name: "Should return the weight of a weighted edge",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1},
+					1: {0},
+				},
+				weights: map[int]map[int]float64{
+					0: {1: 4},
+					1: {0: 4},
+				},
+			},
+			args: args{a: 1, b: 0},
+			want: 4,
+		},
+		{
+			name: "Should return 1 for an edge added without a weight",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1},
+					1: {0},
+				},
+			},
+			args: args{a: 0, b: 1},
+			want: 1,
+		},
+		{
+			name: "Should return error if the edge does not exist",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {},
+					1: {},
+				},
+			},
+			args:    args{a: 0, b: 1},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.graph.Weight(tt.args.a, tt.args.b)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Graph.Weight() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Graph.Weight() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestGraph_RemoveEdge(t *testing.T) {
 	type args struct {
 		a int
@@ -345,14 +562,14 @@ func TestGraph_RemoveEdge(t *testing.T) {
 	}
 	tests := []struct {
 		name      string
-		graph     Graph
+		graph     IntGraph
 		args      args
 		wantNodes map[int][]int
 		wantErr   bool
 	}{
 		{
 			name: "Should remove the edge between two nodes",
-			graph: Graph{
+			graph: IntGraph{
 				nodes: map[int][]int{
 					0: {4, 6, 8},
 					1: {2, 5, 8},
@@ -381,7 +598,7 @@ func TestGraph_RemoveEdge(t *testing.T) {
 		},
 		{
 			name: "Should return error if node a does not exist",
-			graph: Graph{
+			graph: IntGraph{
 				nodes: map[int][]int{
 					0: {4, 6, 8},
 					1: {2, 5, 8},
@@ -410,7 +627,7 @@ func TestGraph_RemoveEdge(t *testing.T) {
 		},
 		{
 			name: "Should return error if node b does not exist",
-			graph: Graph{
+			graph: IntGraph{
 				nodes: map[int][]int{
 					0: {4, 6, 8},
 					1: {2, 5, 8},
@@ -439,7 +656,7 @@ func TestGraph_RemoveEdge(t *testing.T) {
 		},
 		{
 			name: "Should return error if the edge does not exist",
-			graph: Graph{
+			graph: IntGraph{
 				nodes: map[int][]int{
 					0: {4, 6, 8},
 					1: {2, 5, 8},
@@ -495,14 +712,14 @@ func TestGraph_FindShortestPath(t *testing.T) {
 	}
 	tests := []struct {
 		name    string
-		graph   Graph
+		graph   IntGraph
 		args    args
 		want    []int
 		wantErr bool
 	}{
 		{
 			name: "Should find shortest path",
-			graph: Graph{
+			graph: IntGraph{
 				nodes: map[int][]int{
 					0: {4, 6, 8},
 					1: {2, 5, 8},
@@ -519,9 +736,22 @@ func TestGraph_FindShortestPath(t *testing.T) {
 			want:    []int{0, 6, 7, 3},
 			wantErr: false,
 		},
+		{
+			name: "Should return error for a path from a node to itself on a cycle",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1, 2},
+					1: {0, 2},
+					2: {0, 1},
+				},
+			},
+			args:    args{a: 2, b: 2},
+			want:    nil,
+			wantErr: true,
+		},
 		{
 			name: "Should return error if starting node does not exist",
-			graph: Graph{
+			graph: IntGraph{
 				nodes: map[int][]int{
 					0: {4, 6, 8},
 					1: {2, 5, 8},
@@ -540,7 +770,7 @@ func TestGraph_FindShortestPath(t *testing.T) {
 		},
 		{
 			name: "Should return error if node b does not exist",
-			graph: Graph{
+			graph: IntGraph{
 				nodes: map[int][]int{
 					0: {4, 6, 8},
 					1: {2, 5, 8},
@@ -559,7 +789,7 @@ func TestGraph_FindShortestPath(t *testing.T) {
 		},
 		{
 			name: "Should return error if a path does not exist",
-			graph: Graph{
+			graph: IntGraph{
 				nodes: map[int][]int{
 					0:  {4, 6, 8},
 					1:  {2, 5, 8},
@@ -593,6 +823,173 @@ func TestGraph_FindShortestPath(t *testing.T) {
 	}
 }
 
+func TestGraph_FindShortestPathFunc(t *testing.T) {
+	type args struct {
+		a int
+		b int
+	}
+	tests := []struct {
+		name    string
+		graph   IntGraph
+		args    args
+		want    []int
+		wantErr bool
+	}{
+		{
+			name: "Should find the lexicographically smallest shortest path",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {3, 1, 2},
+					1: {0, 5},
+					2: {0, 4},
+					3: {0, 4},
+					4: {2, 3, 6},
+					5: {1, 6},
+					6: {4, 5},
+				},
+			},
+			args: args{a: 0, b: 6},
+			want: []int{0, 1, 5, 6},
+		},
+		{
+			name: "Should not depend on the order of neighbors",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {2, 1, 3},
+					1: {5, 0},
+					2: {4, 0},
+					3: {4, 0},
+					4: {6, 3, 2},
+					5: {6, 1},
+					6: {5, 4},
+				},
+			},
+			args: args{a: 0, b: 6},
+			want: []int{0, 1, 5, 6},
+		},
+		{
+			name: "Should return error for a path from a node to itself",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1},
+					1: {0},
+				},
+			},
+			args:    args{a: 1, b: 1},
+			wantErr: true,
+		},
+		{
+			name: "Should return error if a path does not exist",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1},
+					1: {0},
+					2: {},
+				},
+			},
+			args:    args{a: 0, b: 2},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.graph.FindShortestPathFunc(tt.args.a, tt.args.b, cmp.Compare[int])
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Graph.FindShortestPathFunc() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Graph.FindShortestPathFunc() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGraph_AllShortestPaths(t *testing.T) {
+	type args struct {
+		a int
+		b int
+	}
+	tests := []struct {
+		name    string
+		graph   IntGraph
+		args    args
+		want    [][]int
+		wantErr bool
+	}{
+		{
+			name: "Should find every equal length path",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {4, 6, 8},
+					1: {2, 5, 8},
+					2: {1, 3},
+					3: {2, 7},
+					4: {0, 5},
+					5: {1, 4},
+					6: {0, 7},
+					7: {3, 6},
+					8: {0, 1},
+				},
+			},
+			args: args{a: 3, b: 4},
+			want: [][]int{
+				{3, 2, 1, 5, 4},
+				{3, 7, 6, 0, 4},
+			},
+		},
+		{
+			name: "Should not repeat paths going through parallel edges",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1, 1},
+					1: {0, 0},
+				},
+			},
+			args: args{a: 0, b: 1},
+			want: [][]int{
+				{0, 1},
+			},
+		},
+		{
+			name: "Should return error for a path from a node to itself",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1},
+					1: {0},
+				},
+			},
+			args:    args{a: 0, b: 0},
+			wantErr: true,
+		},
+		{
+			name: "Should return error if end node does not exist",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {},
+				},
+			},
+			args:    args{a: 0, b: 1},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.graph.AllShortestPaths(tt.args.a, tt.args.b)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Graph.AllShortestPaths() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			slices.SortFunc(got, slices.Compare)
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Graph.AllShortestPaths() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestGraph_PathExists(t *testing.T) {
 	type args struct {
 		a int
@@ -600,13 +997,13 @@ func TestGraph_PathExists(t *testing.T) {
 	}
 	tests := []struct {
 		name  string
-		graph Graph
+		graph IntGraph
 		args  args
 		want  bool
 	}{
 		{
 			name: "Should return true if a path exists",
-			graph: Graph{
+			graph: IntGraph{
 				nodes: map[int][]int{
 					0: {4, 6, 8},
 					1: {2, 5, 8},
@@ -622,9 +1019,22 @@ func TestGraph_PathExists(t *testing.T) {
 			args: args{a: 0, b: 3},
 			want: true,
 		},
+		{
+			name: "Should return false for a path from a node to itself",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1, 3},
+					1: {0, 2},
+					2: {1, 3},
+					3: {2, 0},
+				},
+			},
+			args: args{a: 0, b: 0},
+			want: false,
+		},
 		{
 			name: "Should return false if a does not exist",
-			graph: Graph{
+			graph: IntGraph{
 				nodes: map[int][]int{
 					0:  {4, 6, 8},
 					1:  {2, 5, 8},
@@ -655,12 +1065,12 @@ func TestGraph_PathExists(t *testing.T) {
 func TestGraph_CycleExists(t *testing.T) {
 	tests := []struct {
 		name  string
-		graph Graph
+		graph IntGraph
 		want  bool
 	}{
 		{
 			name: "Should return true if a cycle exists",
-			graph: Graph{
+			graph: IntGraph{
 				nodes: map[int][]int{
 					0: {4, 6, 8},
 					1: {2, 5, 8},
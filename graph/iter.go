@@ -0,0 +1,158 @@
+package graph
+
+import "iter"
+
+// Nodes returns an iterator over every node of the graph, in no particular
+// order. The graph must not be modified while iterating.
+func (g *Graph[K]) Nodes() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for node := range g.nodes {
+			if !yield(node) {
+				return
+			}
+		}
+	}
+}
+
+// Edges returns an iterator over every edge of the graph, each one once, in no
+// particular order. Parallel edges are yielded as many times as they were
+// added. The graph must not be modified while iterating.
+func (g *Graph[K]) Edges() iter.Seq[Edge[K]] {
+	return func(yield func(Edge[K]) bool) {
+		done := make(map[K]bool, len(g.nodes))
+		for node, neighbors := range g.nodes {
+			selfLoop := false
+			for _, neighbor := range neighbors {
+				// The edge was yielded from the other end already
+				if done[neighbor] {
+					continue
+				}
+
+				// A self-loop shows up twice in the node's own neighbors
+				if neighbor == node {
+					selfLoop = !selfLoop
+					if !selfLoop {
+						continue
+					}
+				}
+
+				if !yield(Edge[K]{A: node, B: neighbor}) {
+					return
+				}
+			}
+
+			done[node] = true
+		}
+	}
+}
+
+// Neighbors returns an iterator over the nodes connected to node `nodeID`,
+// once for every edge between them. It yields nothing if the node does not
+// exist. The graph must not be modified while iterating.
+func (g *Graph[K]) Neighbors(nodeID K) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for _, neighbor := range g.nodes[nodeID] {
+			if !yield(neighbor) {
+				return
+			}
+		}
+	}
+}
+
+// BFS returns an iterator over the nodes reachable from node `start` in
+// breadth-first order, starting with `start` itself, so nodes come in the
+// order of their distance from it. It yields nothing if the node does not
+// exist. The graph must not be modified while iterating.
+func (g *Graph[K]) BFS(start K) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		if _, ok := g.nodes[start]; !ok {
+			return
+		}
+
+		seen := map[K]bool{start: true}
+		queue := []K{start}
+		for len(queue) > 0 {
+			node := queue[0]
+			queue = queue[1:]
+
+			if !yield(node) {
+				return
+			}
+
+			for _, neighbor := range g.nodes[node] {
+				if seen[neighbor] {
+					continue
+				}
+
+				seen[neighbor] = true
+				queue = append(queue, neighbor)
+			}
+		}
+	}
+}
+
+// DFS returns an iterator over the nodes reachable from node `start` in
+// depth-first pre-order, every node coming before the nodes first reached
+// through it. It yields nothing if the node does not exist. The graph must not
+// be modified while iterating.
+func (g *Graph[K]) DFS(start K) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		g.walkDepthFirst(start, yield, nil)
+	}
+}
+
+// DFSPostOrder returns an iterator over the nodes reachable from node `start`
+// in depth-first post-order, every node coming after the nodes first reached
+// through it, so `start` comes last. It yields nothing if the node does not
+// exist. The graph must not be modified while iterating.
+func (g *Graph[K]) DFSPostOrder(start K) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		g.walkDepthFirst(start, nil, yield)
+	}
+}
+
+// walkDepthFirst runs a depth-first search from node `start`, calling `pre`
+// when entering a node and `post` when leaving it, either of which may be nil.
+// It stops as soon as one of them returns false. The search keeps its own
+// stack, so it does not run out of goroutine stack on long paths.
+func (g *Graph[K]) walkDepthFirst(start K, pre, post func(K) bool) {
+	if _, ok := g.nodes[start]; !ok {
+		return
+	}
+
+	type frame struct {
+		node K
+		next int
+	}
+
+	seen := map[K]bool{start: true}
+	if pre != nil && !pre(start) {
+		return
+	}
+
+	stack := []frame{{node: start}}
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+
+		neighbors := g.nodes[top.node]
+		if top.next == len(neighbors) {
+			stack = stack[:len(stack)-1]
+			if post != nil && !post(top.node) {
+				return
+			}
+			continue
+		}
+
+		neighbor := neighbors[top.next]
+		top.next++
+		if seen[neighbor] {
+			continue
+		}
+
+		seen[neighbor] = true
+		if pre != nil && !pre(neighbor) {
+			return
+		}
+		stack = append(stack, frame{node: neighbor})
+	}
+}
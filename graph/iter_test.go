@@ -0,0 +1,171 @@
+package graph
+
+import (
+	"iter"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// treeGraph has node 0 at the root, with 1 and 2 below it, 3 and 4 below 1
+// and 5 below 2, along with an edge 4-5 closing a cycle and a separate node 6.
+func treeGraph() IntGraph {
+	return IntGraph{
+		nodes: map[int][]int{
+			0: {1, 2},
+			1: {0, 3, 4},
+			2: {0, 5},
+			3: {1},
+			4: {1, 5},
+			5: {2, 4},
+			6: {},
+		},
+	}
+}
+
+func TestGraph_Nodes(t *testing.T) {
+	g := treeGraph()
+
+	got := slices.Sorted(g.Nodes())
+	if want := []int{0, 1, 2, 3, 4, 5, 6}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Graph.Nodes() = %v, want %v", got, want)
+	}
+}
+
+func TestGraph_Edges(t *testing.T) {
+	tests := []struct {
+		name  string
+		graph IntGraph
+		want  []Edge[int]
+	}{
+		{
+			name:  "Should yield every edge once",
+			graph: treeGraph(),
+			want: []Edge[int]{
+				{A: 0, B: 1}, {A: 0, B: 2}, {A: 1, B: 3}, {A: 1, B: 4}, {A: 2, B: 5}, {A: 4, B: 5},
+			},
+		},
+		{
+			name: "Should yield parallel edges and self-loops as many times as they were added",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1, 1, 0, 0},
+					1: {0, 0},
+				},
+				multiEdges: true,
+				selfLoops:  true,
+			},
+			want: []Edge[int]{{A: 0, B: 0}, {A: 0, B: 1}, {A: 0, B: 1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []Edge[int]
+			for edge := range tt.graph.Edges() {
+				if edge.A > edge.B {
+					edge = Edge[int]{A: edge.B, B: edge.A}
+				}
+				got = append(got, edge)
+			}
+			slices.SortFunc(got, func(x, y Edge[int]) int {
+				if x.A != y.A {
+					return x.A - y.A
+				}
+				return x.B - y.B
+			})
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Graph.Edges() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGraph_Traversals(t *testing.T) {
+	g := treeGraph()
+
+	tests := []struct {
+		name string
+		seq  func(start int) iter.Seq[int]
+		args int
+		want []int
+	}{
+		{
+			name: "Should yield the neighbors of a node",
+			seq:  func(start int) iter.Seq[int] { return g.Neighbors(start) },
+			args: 1,
+			want: []int{0, 3, 4},
+		},
+		{
+			name: "Should yield nodes in breadth-first order",
+			seq:  func(start int) iter.Seq[int] { return g.BFS(start) },
+			args: 0,
+			want: []int{0, 1, 2, 3, 4, 5},
+		},
+		{
+			name: "Should yield nodes in depth-first pre-order",
+			seq:  func(start int) iter.Seq[int] { return g.DFS(start) },
+			args: 0,
+			want: []int{0, 1, 3, 4, 5, 2},
+		},
+		{
+			name: "Should yield nodes in depth-first post-order",
+			seq:  func(start int) iter.Seq[int] { return g.DFSPostOrder(start) },
+			args: 0,
+			want: []int{3, 2, 5, 4, 1, 0},
+		},
+		{
+			name: "Should only yield the start node if it has no edges",
+			seq:  func(start int) iter.Seq[int] { return g.DFS(start) },
+			args: 6,
+			want: []int{6},
+		},
+		{
+			name: "Should yield nothing if the start node does not exist",
+			seq:  func(start int) iter.Seq[int] { return g.BFS(start) },
+			args: 9,
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slices.Collect(tt.seq(tt.args)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("traversal = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGraph_TraversalsBreakEarly(t *testing.T) {
+	g := treeGraph()
+
+	traversals := map[string]func(int) iter.Seq[int]{
+		"Neighbors":    func(start int) iter.Seq[int] { return g.Neighbors(start) },
+		"BFS":          func(start int) iter.Seq[int] { return g.BFS(start) },
+		"DFS":          func(start int) iter.Seq[int] { return g.DFS(start) },
+		"DFSPostOrder": func(start int) iter.Seq[int] { return g.DFSPostOrder(start) },
+	}
+	for name, traversal := range traversals {
+		t.Run(name, func(t *testing.T) {
+			var got []int
+			for node := range traversal(1) {
+				got = append(got, node)
+				if len(got) == 2 {
+					break
+				}
+			}
+			if len(got) != 2 {
+				t.Errorf("Graph.%v() yielded %v after break, want 2 nodes", name, got)
+			}
+		})
+	}
+
+	count := 0
+	for range g.Edges() {
+		count++
+		break
+	}
+	if count != 1 {
+		t.Errorf("Graph.Edges() yielded %v edges after break, want 1", count)
+	}
+}
@@ -0,0 +1,217 @@
+package graph
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+)
+
+type jsonGraph[K comparable] struct {
+	MultiEdges bool               `json:"multiEdges,omitempty"`
+	SelfLoops  bool               `json:"selfLoops,omitempty"`
+	Nodes      []jsonNode[K]      `json:"nodes"`
+	Weights    []jsonWeight[K]    `json:"weights,omitempty"`
+	EdgeAttrs  []jsonEdgeAttrs[K] `json:"edgeAttrs,omitempty"`
+}
+
+type jsonNode[K comparable] struct {
+	ID        K                          `json:"id"`
+	Neighbors []K                        `json:"neighbors"`
+	Attrs     map[string]json.RawMessage `json:"attrs,omitempty"`
+}
+
+type jsonEdgeAttrs[K comparable] struct {
+	A     K                          `json:"a"`
+	B     K                          `json:"b"`
+	Attrs map[string]json.RawMessage `json:"attrs"`
+}
+
+type jsonWeight[K comparable] struct {
+	A      K           `json:"a"`
+	B      K           `json:"b"`
+	Weight jsonFloat64 `json:"weight"`
+}
+
+// jsonFloat64 is a weight encoded as a JSON number, or as the string "+Inf"
+// for an infinite weight, which a JSON number can't hold.
+type jsonFloat64 float64
+
+func (f jsonFloat64) MarshalJSON() ([]byte, error) {
+	if math.IsInf(float64(f), 1) {
+		return []byte(`"+Inf"`), nil
+	}
+
+	return json.Marshal(float64(f))
+}
+
+func (f *jsonFloat64) UnmarshalJSON(b []byte) error {
+	if string(b) == `"+Inf"` {
+		*f = jsonFloat64(math.Inf(1))
+		return nil
+	}
+
+	var value float64
+	err := json.Unmarshal(b, &value)
+	if err != nil {
+		return fmt.Errorf("invalid weight %s, expected a number or \"+Inf\"", b)
+	}
+
+	*f = jsonFloat64(value)
+
+	return nil
+}
+
+// MarshalJSON encodes the graph as a list of nodes with their neighbors and
+// attributes, followed by the weights and attributes of edges, each edge
+// listed once. An infinite weight is encoded as the string "+Inf". The edge
+// policy is encoded too, but edge ids are not.
+func (g *Graph[K]) MarshalJSON() ([]byte, error) {
+	data := jsonGraph[K]{
+		MultiEdges: g.multiEdges,
+		SelfLoops:  g.selfLoops,
+		Nodes:      make([]jsonNode[K], 0, len(g.nodes)),
+	}
+
+	for _, node := range g.sortedNodes() {
+		neighbors := g.nodes[node]
+		if neighbors == nil {
+			neighbors = []K{}
+		}
+
+		attrs, err := encodeAttrs(g.nodeAttrs[node])
+		if err != nil {
+			return nil, fmt.Errorf("error encoding attributes of node %v: %w", node, err)
+		}
+
+		data.Nodes = append(data.Nodes, jsonNode[K]{
+			ID:        node,
+			Neighbors: neighbors,
+			Attrs:     attrs,
+		})
+	}
+
+	edges := slices.Compact(g.sortedEdges())
+	for _, edge := range edges {
+		weight, ok := g.weights[edge[0]][edge[1]]
+		if !ok {
+			continue
+		}
+
+		data.Weights = append(data.Weights, jsonWeight[K]{
+			A:      edge[0],
+			B:      edge[1],
+			Weight: jsonFloat64(weight),
+		})
+	}
+
+	for _, edge := range edges {
+		attrs, err := encodeAttrs(g.edgeAttrs[edge[0]][edge[1]])
+		if err != nil {
+			return nil, fmt.Errorf("error encoding attributes of edge between node %v and node %v: %w", edge[0], edge[1], err)
+		}
+		if attrs == nil {
+			continue
+		}
+
+		data.EdgeAttrs = append(data.EdgeAttrs, jsonEdgeAttrs[K]{
+			A:     edge[0],
+			B:     edge[1],
+			Attrs: attrs,
+		})
+	}
+
+	return json.Marshal(data)
+}
+
+// UnmarshalJSON decodes a graph encoded by MarshalJSON, ensuring the relations
+// are mutual and valid the same way New does. On error the graph is left
+// unchanged. Edges of a multigraph get new ids. Attribute values are decoded
+// once they are read with NodeAttr or EdgeAttr, into the type requested.
+func (g *Graph[K]) UnmarshalJSON(b []byte) error {
+	var data jsonGraph[K]
+	err := json.Unmarshal(b, &data)
+	if err != nil {
+		return fmt.Errorf("error decoding graph: %w", err)
+	}
+
+	nodes := make(map[K][]K, len(data.Nodes))
+	for _, node := range data.Nodes {
+		if _, ok := nodes[node.ID]; ok {
+			return fmt.Errorf("provided nodes are invalid, node %v is listed more than once", node.ID)
+		}
+
+		nodes[node.ID] = node.Neighbors
+		if nodes[node.ID] == nil {
+			nodes[node.ID] = []K{}
+		}
+	}
+
+	var opts []Option
+	if data.MultiEdges {
+		opts = append(opts, WithMultiEdges())
+	}
+	if data.SelfLoops {
+		opts = append(opts, WithSelfLoops())
+	}
+
+	decoded, err := New(nodes, opts...)
+	if err != nil {
+		return err
+	}
+
+	weights := make(map[[2]K]float64, len(data.Weights))
+	for _, w := range data.Weights {
+		weight := float64(w.Weight)
+		if weight < 0 || math.IsNaN(weight) {
+			return fmt.Errorf("invalid weight %v, expected a non-negative number", weight)
+		}
+		if _, err := decoded.Weight(w.A, w.B); err != nil {
+			return err
+		}
+		if err := addReadWeight(weights, w.A, w.B, weight); err != nil {
+			return err
+		}
+
+		decoded.setWeight(w.A, w.B, weight)
+		decoded.setWeight(w.B, w.A, weight)
+	}
+
+	for _, node := range data.Nodes {
+		for key, value := range node.Attrs {
+			if err := decoded.SetNodeAttr(node.ID, key, jsonAttr(value)); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, edge := range data.EdgeAttrs {
+		for key, value := range edge.Attrs {
+			if err := decoded.SetEdgeAttr(edge.A, edge.B, key, jsonAttr(value)); err != nil {
+				return err
+			}
+		}
+	}
+
+	*g = *decoded
+
+	return nil
+}
+
+func encodeAttrs(attrs map[string]any) (map[string]json.RawMessage, error) {
+	if len(attrs) == 0 {
+		return nil, nil
+	}
+
+	encoded := make(map[string]json.RawMessage, len(attrs))
+	for key, value := range attrs {
+		data, err := json.Marshal(encodableAttr(value))
+		if err != nil {
+			return nil, fmt.Errorf("attribute %q: %w", key, err)
+		}
+
+		encoded[key] = data
+	}
+
+	return encoded, nil
+}
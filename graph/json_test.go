@@ -0,0 +1,188 @@
+package graph
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestGraph_MarshalJSON(t *testing.T) {
+	g := IntGraph{
+		nodes: map[int][]int{
+			10: {2},
+			2:  {10, 3},
+			3:  {2},
+			4:  {},
+		},
+		weights: map[int]map[int]float64{
+			2: {3: 1.5},
+			3: {2: 1.5},
+		},
+	}
+
+	got, err := json.Marshal(&g)
+	if err != nil {
+		t.Fatalf("Graph.MarshalJSON() error = %v", err)
+	}
+
+	want := `{"nodes":[{"id":2,"neighbors":[10,3]},{"id":3,"neighbors":[2]},{"id":4,"neighbors":[]},{"id":10,"neighbors":[2]}],"weights":[{"a":2,"b":3,"weight":1.5}]}`
+	if string(got) != want {
+		t.Errorf("Graph.MarshalJSON() = %s, want %s", got, want)
+	}
+}
+
+func TestGraph_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        string
+		wantNodes   map[string][]string
+		wantWeights map[string]map[string]float64
+		wantErr     bool
+	}{
+		{
+			name: "Should decode nodes and weights",
+			data: `{"nodes":[{"id":"a","neighbors":["b"]},{"id":"b","neighbors":["a"]},{"id":"c","neighbors":[]}],"weights":[{"a":"b","b":"a","weight":3}]}`,
+			wantNodes: map[string][]string{
+				"a": {"b"},
+				"b": {"a"},
+				"c": {},
+			},
+			wantWeights: map[string]map[string]float64{
+				"a": {"b": 3},
+				"b": {"a": 3},
+			},
+		},
+		{
+			name:    "Should return error if a relation is not mutual",
+			data:    `{"nodes":[{"id":"a","neighbors":["b"]},{"id":"b","neighbors":[]}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "Should return error if a node is listed twice",
+			data:    `{"nodes":[{"id":"a","neighbors":[]},{"id":"a","neighbors":[]}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "Should return error if a weight is given for a missing edge",
+			data:    `{"nodes":[{"id":"a","neighbors":[]},{"id":"b","neighbors":[]}],"weights":[{"a":"a","b":"b","weight":1}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "Should return error if a weight is negative",
+			data:    `{"nodes":[{"id":"a","neighbors":["b"]},{"id":"b","neighbors":["a"]}],"weights":[{"a":"a","b":"b","weight":-1}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "Should return error if a weight is a string other than +Inf",
+			data:    `{"nodes":[{"id":"a","neighbors":["b"]},{"id":"b","neighbors":["a"]}],"weights":[{"a":"a","b":"b","weight":"Inf"}]}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var g Graph[string]
+			err := json.Unmarshal([]byte(tt.data), &g)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Graph.UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				if g.nodes != nil {
+					t.Errorf("Graph.UnmarshalJSON() changed the graph on error: %v", g.nodes)
+				}
+				return
+			}
+
+			if !reflect.DeepEqual(g.nodes, tt.wantNodes) {
+				t.Errorf("Graph.UnmarshalJSON() Graph.nodes = %v, want %v", g.nodes, tt.wantNodes)
+			}
+			if !reflect.DeepEqual(g.weights, tt.wantWeights) {
+				t.Errorf("Graph.UnmarshalJSON() Graph.weights = %v, want %v", g.weights, tt.wantWeights)
+			}
+		})
+	}
+}
+
+func TestGraph_JSONRoundTrip(t *testing.T) {
+	type service struct {
+		Name   string
+		Region string
+	}
+
+	api := service{Name: "api", Region: "eu"}
+	db := service{Name: "db", Region: "us"}
+
+	g, err := New(map[service][]service{
+		api: {db},
+		db:  {api},
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	data, err := json.Marshal(g)
+	if err != nil {
+		t.Fatalf("Graph.MarshalJSON() error = %v", err)
+	}
+
+	var got Graph[service]
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Graph.UnmarshalJSON() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(got.nodes, g.nodes) {
+		t.Errorf("JSON round trip Graph.nodes = %v, want %v", got.nodes, g.nodes)
+	}
+}
+
+func TestGraph_JSONRoundTrip_EdgePolicy(t *testing.T) {
+	g, err := New(map[int][]int{
+		0: {0, 0, 1, 1},
+		1: {0, 0},
+	}, WithMultiEdges(), WithSelfLoops())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	data, err := json.Marshal(g)
+	if err != nil {
+		t.Fatalf("Graph.MarshalJSON() error = %v", err)
+	}
+
+	var got IntGraph
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Graph.UnmarshalJSON() error = %v", err)
+	}
+
+	if !got.IsMultigraph() || !got.AllowsSelfLoops() {
+		t.Errorf("JSON round trip lost the edge policy: %s", data)
+	}
+	if got.Multiplicity(0, 1) != 2 || got.Multiplicity(0, 0) != 1 {
+		t.Errorf("JSON round trip Graph.nodes = %v, want %v", got.nodes, g.nodes)
+	}
+}
+
+func TestGraph_JSONRoundTrip_InfiniteWeight(t *testing.T) {
+	g, err := New(map[int][]int{0: {1}, 1: {0}, 2: {}})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if err := g.AddWeightedEdge(0, 2, math.Inf(1)); err != nil {
+		t.Fatalf("Graph.AddWeightedEdge() error = %v", err)
+	}
+
+	data, err := json.Marshal(g)
+	if err != nil {
+		t.Fatalf("Graph.MarshalJSON() error = %v", err)
+	}
+
+	var got IntGraph
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Graph.UnmarshalJSON() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(got.weights, g.weights) {
+		t.Errorf("JSON round trip Graph.weights = %v, want %v", got.weights, g.weights)
+	}
+}
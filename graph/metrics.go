@@ -0,0 +1,242 @@
+package graph
+
+import (
+	"fmt"
+	"math"
+)
+
+// Degree returns the number of edges of node `nodeID`, a self-loop counting
+// twice since both of its ends are at the node.
+func (g *Graph[K]) Degree(nodeID K) (int, error) {
+	neighbors, ok := g.nodes[nodeID]
+	if !ok {
+		return 0, fmt.Errorf("node %v does not exist in the graph", nodeID)
+	}
+
+	return len(neighbors), nil
+}
+
+// DegreeHistogram returns how many nodes of the graph have each degree, the
+// count of nodes of degree d being at index d. The last element is always
+// non-zero, unless the graph is empty in which case the histogram is too.
+func (g *Graph[K]) DegreeHistogram() []int {
+	var histogram []int
+	for _, neighbors := range g.nodes {
+		for len(histogram) <= len(neighbors) {
+			histogram = append(histogram, 0)
+		}
+		histogram[len(neighbors)]++
+	}
+
+	return histogram
+}
+
+// Eccentricity returns the largest number of edges on the shortest path from
+// node `nodeID` to any other node. Returns error if some node is not reachable
+// from it, since the eccentricity is then infinite.
+func (g *Graph[K]) Eccentricity(nodeID K) (int, error) {
+	dist, err := g.distancesFrom(nodeID)
+	if err != nil {
+		return 0, fmt.Errorf("node %v does not exist in the graph", nodeID)
+	}
+	if len(dist) < len(g.nodes) {
+		return 0, fmt.Errorf("graph is not connected, eccentricity of node %v is infinite", nodeID)
+	}
+
+	eccentricity := 0
+	for _, d := range dist {
+		eccentricity = max(eccentricity, d)
+	}
+
+	return eccentricity, nil
+}
+
+// Radius returns the smallest eccentricity of any node of the graph. Returns
+// error if the graph is empty or not connected.
+func (g *Graph[K]) Radius() (int, error) {
+	eccentricities, err := g.eccentricities()
+	if err != nil {
+		return 0, err
+	}
+
+	radius := math.MaxInt
+	for _, eccentricity := range eccentricities {
+		radius = min(radius, eccentricity)
+	}
+
+	return radius, nil
+}
+
+// Diameter returns the largest eccentricity of any node of the graph, the
+// number of edges on the longest shortest path. Returns error if the graph is
+// empty or not connected.
+func (g *Graph[K]) Diameter() (int, error) {
+	eccentricities, err := g.eccentricities()
+	if err != nil {
+		return 0, err
+	}
+
+	diameter := 0
+	for _, eccentricity := range eccentricities {
+		diameter = max(diameter, eccentricity)
+	}
+
+	return diameter, nil
+}
+
+// eccentricities returns the eccentricity of every node, running a
+// breadth-first search from each of them.
+func (g *Graph[K]) eccentricities() (map[K]int, error) {
+	if len(g.nodes) == 0 {
+		return nil, fmt.Errorf("graph is empty")
+	}
+
+	eccentricities := make(map[K]int, len(g.nodes))
+	for node := range g.nodes {
+		eccentricity, err := g.Eccentricity(node)
+		if err != nil {
+			return nil, err
+		}
+		eccentricities[node] = eccentricity
+	}
+
+	return eccentricities, nil
+}
+
+// BetweennessCentrality returns for every node the number of shortest paths
+// between other nodes that go through it, a pair of nodes with several
+// shortest paths adding the fraction of them going through it. Each pair of
+// nodes is counted once and edges are not weighted. It uses Brandes'
+// algorithm, which takes O(V·E) time.
+func (g *Graph[K]) BetweennessCentrality() map[K]float64 {
+	centrality := make(map[K]float64, len(g.nodes))
+	for node := range g.nodes {
+		centrality[node] = 0
+	}
+
+	for source := range g.nodes {
+		// Count the shortest paths from the source to every node, keeping the
+		// nodes in the order they were reached
+		dist := map[K]int{source: 0}
+		paths := map[K]float64{source: 1}
+		predecessors := make(map[K][]K)
+		var order []K
+
+		queue := []K{source}
+		for len(queue) > 0 {
+			node := queue[0]
+			queue = queue[1:]
+			order = append(order, node)
+
+			for _, neighbor := range g.nodes[node] {
+				if _, ok := dist[neighbor]; !ok {
+					dist[neighbor] = dist[node] + 1
+					queue = append(queue, neighbor)
+				}
+				if dist[neighbor] == dist[node]+1 {
+					paths[neighbor] += paths[node]
+					predecessors[neighbor] = append(predecessors[neighbor], node)
+				}
+			}
+		}
+
+		// Walk back from the farthest nodes, passing on to every predecessor
+		// its share of the paths going through the node
+		dependency := make(map[K]float64, len(order))
+		for i := len(order) - 1; i > 0; i-- {
+			node := order[i]
+			for _, predecessor := range predecessors[node] {
+				dependency[predecessor] += paths[predecessor] / paths[node] * (1 + dependency[node])
+			}
+			centrality[node] += dependency[node]
+		}
+	}
+
+	// Every path was counted from both of its ends
+	for node := range centrality {
+		centrality[node] /= 2
+	}
+
+	return centrality
+}
+
+// ClosenessCentrality returns for every node the number of other nodes it
+// reaches divided by the sum of the number of edges on the shortest paths to
+// them, so that nodes close to all the others score near 1. A node reaching
+// no other node scores 0.
+func (g *Graph[K]) ClosenessCentrality() map[K]float64 {
+	centrality := make(map[K]float64, len(g.nodes))
+	for node := range g.nodes {
+		dist, _ := g.distancesFrom(node)
+
+		total := 0
+		for _, d := range dist {
+			total += d
+		}
+
+		centrality[node] = 0
+		if total > 0 {
+			centrality[node] = float64(len(dist)-1) / float64(total)
+		}
+	}
+
+	return centrality
+}
+
+const (
+	// pageRankTolerance is the largest total change of the ranks between two
+	// iterations at which PageRank considers them settled.
+	pageRankTolerance = 1e-10
+	// pageRankMaxIterations bounds the number of iterations of PageRank.
+	pageRankMaxIterations = 1000
+)
+
+// PageRank returns the PageRank of every node, the chance of a random surfer
+// being at the node, who follows a random edge of the current node with
+// probability `damping` and jumps to a random node otherwise. Every edge can
+// be followed either way and a node without edges leads to any node. The
+// ranks add up to 1. Damping must be between 0 and 1, 0.85 being the usual
+// choice.
+func (g *Graph[K]) PageRank(damping float64) (map[K]float64, error) {
+	if damping < 0 || damping > 1 || math.IsNaN(damping) {
+		return nil, fmt.Errorf("invalid damping %v, expected a number between 0 and 1", damping)
+	}
+
+	n := float64(len(g.nodes))
+	rank := make(map[K]float64, len(g.nodes))
+	for node := range g.nodes {
+		rank[node] = 1 / n
+	}
+
+	for range pageRankMaxIterations {
+		// The rank of nodes without edges is spread evenly over all nodes
+		dangling := 0.0
+		for node, neighbors := range g.nodes {
+			if len(neighbors) == 0 {
+				dangling += rank[node]
+			}
+		}
+
+		next := make(map[K]float64, len(g.nodes))
+		for node := range g.nodes {
+			next[node] = (1-damping)/n + damping*dangling/n
+		}
+		for node, neighbors := range g.nodes {
+			for _, neighbor := range neighbors {
+				next[neighbor] += damping * rank[node] / float64(len(neighbors))
+			}
+		}
+
+		change := 0.0
+		for node := range g.nodes {
+			change += math.Abs(next[node] - rank[node])
+		}
+
+		rank = next
+		if change < pageRankTolerance {
+			break
+		}
+	}
+
+	return rank, nil
+}
@@ -0,0 +1,292 @@
+package graph
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+// pathGraph5 is the path 0-1-2-3-4.
+func pathGraph5() IntGraph {
+	return IntGraph{
+		nodes: map[int][]int{
+			0: {1},
+			1: {0, 2},
+			2: {1, 3},
+			3: {2, 4},
+			4: {3},
+		},
+	}
+}
+
+// disconnectedGraph has an edge 0-1 and a separate node 2.
+func disconnectedGraph() IntGraph {
+	return IntGraph{
+		nodes: map[int][]int{
+			0: {1},
+			1: {0},
+			2: {},
+		},
+	}
+}
+
+func TestGraph_Degree(t *testing.T) {
+	g := IntGraph{
+		nodes: map[int][]int{
+			0: {1, 0, 0},
+			1: {0},
+		},
+		selfLoops: true,
+	}
+
+	if got, err := g.Degree(0); err != nil || got != 3 {
+		t.Errorf("Graph.Degree() = %v, %v, want 3", got, err)
+	}
+	if got, err := g.Degree(1); err != nil || got != 1 {
+		t.Errorf("Graph.Degree() = %v, %v, want 1", got, err)
+	}
+	if _, err := g.Degree(9); err == nil {
+		t.Errorf("Graph.Degree() expected error for a non-existent node")
+	}
+}
+
+func TestGraph_DegreeHistogram(t *testing.T) {
+	tests := []struct {
+		name  string
+		graph IntGraph
+		want  []int
+	}{
+		{
+			name:  "Should count nodes of every degree",
+			graph: pathGraph5(),
+			want:  []int{0, 2, 3},
+		},
+		{
+			name:  "Should count nodes without edges",
+			graph: disconnectedGraph(),
+			want:  []int{1, 2},
+		},
+		{
+			name:  "Should return nothing for an empty graph",
+			graph: IntGraph{nodes: map[int][]int{}},
+			want:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.graph.DegreeHistogram(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Graph.DegreeHistogram() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGraph_Eccentricity(t *testing.T) {
+	g := pathGraph5()
+	for node, want := range []int{4, 3, 2, 3, 4} {
+		if got, err := g.Eccentricity(node); err != nil || got != want {
+			t.Errorf("Graph.Eccentricity(%v) = %v, %v, want %v", node, got, err, want)
+		}
+	}
+
+	if _, err := g.Eccentricity(9); err == nil {
+		t.Errorf("Graph.Eccentricity() expected error for a non-existent node")
+	}
+
+	disconnected := disconnectedGraph()
+	if _, err := disconnected.Eccentricity(0); err == nil {
+		t.Errorf("Graph.Eccentricity() expected error for a disconnected graph")
+	}
+}
+
+func TestGraph_RadiusDiameter(t *testing.T) {
+	tests := []struct {
+		name         string
+		graph        IntGraph
+		wantRadius   int
+		wantDiameter int
+		wantErr      bool
+	}{
+		{
+			name:         "Should find radius and diameter of a path",
+			graph:        pathGraph5(),
+			wantRadius:   2,
+			wantDiameter: 4,
+		},
+		{
+			name:         "Should find radius and diameter of a single node",
+			graph:        IntGraph{nodes: map[int][]int{0: {}}},
+			wantRadius:   0,
+			wantDiameter: 0,
+		},
+		{
+			name:    "Should return error for a disconnected graph",
+			graph:   disconnectedGraph(),
+			wantErr: true,
+		},
+		{
+			name:    "Should return error for an empty graph",
+			graph:   IntGraph{nodes: map[int][]int{}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			radius, err := tt.graph.Radius()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Graph.Radius() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if radius != tt.wantRadius {
+				t.Errorf("Graph.Radius() = %v, want %v", radius, tt.wantRadius)
+			}
+
+			diameter, err := tt.graph.Diameter()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Graph.Diameter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diameter != tt.wantDiameter {
+				t.Errorf("Graph.Diameter() = %v, want %v", diameter, tt.wantDiameter)
+			}
+		})
+	}
+}
+
+func TestGraph_BetweennessCentrality(t *testing.T) {
+	tests := []struct {
+		name  string
+		graph IntGraph
+		want  map[int]float64
+	}{
+		{
+			name:  "Should count shortest paths through every node",
+			graph: pathGraph5(),
+			want:  map[int]float64{0: 0, 1: 3, 2: 4, 3: 3, 4: 0},
+		},
+		{
+			name: "Should split pairs with several shortest paths",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1, 3},
+					1: {0, 2},
+					2: {1, 3},
+					3: {2, 0},
+				},
+			},
+			want: map[int]float64{0: 0.5, 1: 0.5, 2: 0.5, 3: 0.5},
+		},
+		{
+			name:  "Should not count pairs without a path",
+			graph: disconnectedGraph(),
+			want:  map[int]float64{0: 0, 1: 0, 2: 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.graph.BetweennessCentrality(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Graph.BetweennessCentrality() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGraph_ClosenessCentrality(t *testing.T) {
+	tests := []struct {
+		name  string
+		graph IntGraph
+		want  map[int]float64
+	}{
+		{
+			name:  "Should score nodes by their distance to the others",
+			graph: pathGraph5(),
+			want:  map[int]float64{0: 0.4, 1: 4.0 / 7, 2: 4.0 / 6, 3: 4.0 / 7, 4: 0.4},
+		},
+		{
+			name:  "Should only count reachable nodes",
+			graph: disconnectedGraph(),
+			want:  map[int]float64{0: 1, 1: 1, 2: 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.graph.ClosenessCentrality(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Graph.ClosenessCentrality() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGraph_PageRank(t *testing.T) {
+	tests := []struct {
+		name    string
+		graph   IntGraph
+		damping float64
+		want    map[int]float64
+		wantErr bool
+	}{
+		{
+			name: "Should rank every node of a cycle equally",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1, 3},
+					1: {0, 2},
+					2: {1, 3},
+					3: {2, 0},
+				},
+			},
+			damping: 0.85,
+			want:    map[int]float64{0: 0.25, 1: 0.25, 2: 0.25, 3: 0.25},
+		},
+		{
+			name: "Should rank the center of a star highest",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1, 2, 3},
+					1: {0},
+					2: {0},
+					3: {0},
+				},
+			},
+			// The center gets r0 = 0.15/4 + 0.85·3·r1 with r0 + 3·r1 = 1
+			damping: 0.85,
+			want: map[int]float64{
+				0: (0.15/4 + 0.85) / 1.85,
+				1: (1 - (0.15/4+0.85)/1.85) / 3,
+				2: (1 - (0.15/4+0.85)/1.85) / 3,
+				3: (1 - (0.15/4+0.85)/1.85) / 3,
+			},
+		},
+		{
+			name:  "Should spread the rank of nodes without edges",
+			graph: disconnectedGraph(),
+			// Node 2 gets r2 = 0.15/3 + 0.85·r2/3 back from itself
+			damping: 0.85,
+			want: map[int]float64{
+				0: (1 - 0.15/2.15) / 2,
+				1: (1 - 0.15/2.15) / 2,
+				2: 0.15 / 2.15,
+			},
+		},
+		{
+			name:    "Should return error if damping is out of range",
+			graph:   pathGraph5(),
+			damping: 1.5,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.graph.PageRank(tt.damping)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Graph.PageRank() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			for node, want := range tt.want {
+				if math.Abs(got[node]-want) > 1e-9 {
+					t.Errorf("Graph.PageRank() = %v, want %v", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package graph
+
+import (
+	"cmp"
+	"container/heap"
+	"slices"
+)
+
+// MinimumSpanningTreeKruskal returns a minimum spanning tree of the graph as a
+// new graph with the same nodes, along with its total weight, using Kruskal's
+// algorithm. If the graph is disconnected, the result is a minimum spanning
+// forest, with a tree for every connected component. Edges added without a
+// weight weigh 1, and self-loops are never part of the tree.
+func (g *Graph[K]) MinimumSpanningTreeKruskal() (*Graph[K], float64) {
+	tree := g.emptyForest()
+
+	// Parallel edges share their weight, so one of them is enough
+	edges := slices.Compact(g.sortedEdges())
+	slices.SortStableFunc(edges, func(x, y [2]K) int {
+		return cmp.Compare(g.weight(x[0], x[1]), g.weight(y[0], y[1]))
+	})
+
+	sets := newUnionFind[K](len(g.nodes))
+	for node := range g.nodes {
+		sets.add(node)
+	}
+
+	total := 0.0
+	for _, edge := range edges {
+		// Joining nodes of the same tree would close a cycle
+		if !sets.union(edge[0], edge[1]) {
+			continue
+		}
+
+		weight := g.weight(edge[0], edge[1])
+		_ = tree.AddWeightedEdge(edge[0], edge[1], weight)
+		total += weight
+	}
+
+	return tree, total
+}
+
+// MinimumSpanningTreePrim returns a minimum spanning tree of the graph as a new
+// graph with the same nodes, along with its total weight, using Prim's
+// algorithm. If the graph is disconnected, the result is a minimum spanning
+// forest, with a tree for every connected component. Edges added without a
+// weight weigh 1, and self-loops are never part of the tree.
+func (g *Graph[K]) MinimumSpanningTreePrim() (*Graph[K], float64) {
+	tree := g.emptyForest()
+
+	inTree := make(map[K]bool, len(g.nodes))
+	best := make(map[K]float64)
+	from := make(map[K]K)
+
+	total := 0.0
+	for _, root := range g.sortedNodes() {
+		if inTree[root] {
+			continue
+		}
+
+		// Grow a tree from every node not reached yet, one per component
+		queue := &priorityQueue[K]{{node: root, priority: 0}}
+		for queue.Len() > 0 {
+			item := heap.Pop(queue).(queueItem[K])
+
+			// Stale entry, the node was already reached through a lighter edge
+			if inTree[item.node] || (item.node != root && item.priority > best[item.node]) {
+				continue
+			}
+			inTree[item.node] = true
+
+			if item.node != root {
+				_ = tree.AddWeightedEdge(from[item.node], item.node, item.priority)
+				total += item.priority
+			}
+
+			for _, neighbor := range g.nodes[item.node] {
+				if inTree[neighbor] {
+					continue
+				}
+
+				weight := g.weight(item.node, neighbor)
+				if current, ok := best[neighbor]; ok && current <= weight {
+					continue
+				}
+
+				best[neighbor] = weight
+				from[neighbor] = item.node
+				heap.Push(queue, queueItem[K]{node: neighbor, priority: weight})
+			}
+		}
+	}
+
+	return tree, total
+}
+
+// emptyForest returns a graph with the same nodes as the graph and no edges.
+func (g *Graph[K]) emptyForest() *Graph[K] {
+	nodes := make(map[K][]K, len(g.nodes))
+	for node := range g.nodes {
+		nodes[node] = []K{}
+	}
+
+	forest, _ := New(nodes)
+
+	return forest
+}
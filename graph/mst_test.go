@@ -0,0 +1,144 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGraph_MinimumSpanningTree(t *testing.T) {
+	tests := []struct {
+		name      string
+		graph     IntGraph
+		want      map[int][]int
+		wantTotal float64
+	}{
+		{
+			name: "Should pick the lightest edges that do not close a cycle",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1, 2, 3},
+					1: {0, 2},
+					2: {0, 1, 3, 4},
+					3: {0, 2, 4},
+					4: {2, 3},
+				},
+				weights: map[int]map[int]float64{
+					0: {1: 4, 2: 3, 3: 7},
+					1: {0: 4, 2: 1},
+					2: {0: 3, 1: 1, 3: 5, 4: 8},
+					3: {0: 7, 2: 5, 4: 2},
+					4: {2: 8, 3: 2},
+				},
+			},
+			want: map[int][]int{
+				0: {2},
+				1: {2},
+				2: {0, 1, 3},
+				3: {2, 4},
+				4: {3},
+			},
+			wantTotal: 11,
+		},
+		{
+			name: "Should treat edges without a weight as weighing 1",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1, 2},
+					1: {0, 2},
+					2: {0, 1},
+				},
+				weights: map[int]map[int]float64{
+					0: {1: 3},
+					1: {0: 3},
+				},
+			},
+			want: map[int][]int{
+				0: {2},
+				1: {2},
+				2: {0, 1},
+			},
+			wantTotal: 2,
+		},
+		{
+			name: "Should return a spanning forest of a disconnected graph",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1, 2},
+					1: {0, 2},
+					2: {0, 1},
+					3: {4},
+					4: {3},
+					5: {},
+				},
+				weights: map[int]map[int]float64{
+					0: {1: 1, 2: 2},
+					1: {0: 1, 2: 0.5},
+					2: {0: 2, 1: 0.5},
+					3: {4: 6},
+					4: {3: 6},
+				},
+			},
+			want: map[int][]int{
+				0: {1},
+				1: {0, 2},
+				2: {1},
+				3: {4},
+				4: {3},
+				5: {},
+			},
+			wantTotal: 7.5,
+		},
+		{
+			name: "Should ignore self-loops and parallel edges",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {0, 0, 1, 1},
+					1: {0, 0},
+				},
+				multiEdges: true,
+				selfLoops:  true,
+			},
+			want: map[int][]int{
+				0: {1},
+				1: {0},
+			},
+			wantTotal: 1,
+		},
+		{
+			name: "Should return an empty graph for an empty graph",
+			graph: IntGraph{
+				nodes: map[int][]int{},
+			},
+			want:      map[int][]int{},
+			wantTotal: 0,
+		},
+	}
+	for _, tt := range tests {
+		algorithms := map[string]func() (*Graph[int], float64){
+			"Kruskal": tt.graph.MinimumSpanningTreeKruskal,
+			"Prim":    tt.graph.MinimumSpanningTreePrim,
+		}
+		for algorithm, mst := range algorithms {
+			t.Run(tt.name+" with "+algorithm, func(t *testing.T) {
+				got, gotTotal := mst()
+
+				sortAdjacency(got.nodes)
+				if !reflect.DeepEqual(got.nodes, tt.want) {
+					t.Errorf("Graph.MinimumSpanningTree%v() = %v, want %v", algorithm, got.nodes, tt.want)
+				}
+				if gotTotal != tt.wantTotal {
+					t.Errorf("Graph.MinimumSpanningTree%v() total = %v, want %v", algorithm, gotTotal, tt.wantTotal)
+				}
+
+				// The tree keeps the weights of its edges
+				for node, neighbors := range got.nodes {
+					for _, neighbor := range neighbors {
+						if got.weight(node, neighbor) != tt.graph.weight(node, neighbor) {
+							t.Errorf("Graph.MinimumSpanningTree%v() weight of %v-%v = %v, want %v", algorithm, node, neighbor, got.weight(node, neighbor), tt.graph.weight(node, neighbor))
+						}
+					}
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package graph
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Option configures a graph created by New.
+type Option func(*graphOptions)
+
+type graphOptions struct {
+	multiEdges bool
+	selfLoops  bool
+}
+
+// WithMultiEdges makes a multigraph, allowing any number of parallel edges
+// between two nodes. Every edge of a multigraph gets an EdgeID, so that a
+// single parallel edge can be told apart from the others.
+func WithMultiEdges() Option {
+	return func(o *graphOptions) {
+		o.multiEdges = true
+	}
+}
+
+// WithSelfLoops allows edges going from a node to itself.
+func WithSelfLoops() Option {
+	return func(o *graphOptions) {
+		o.selfLoops = true
+	}
+}
+
+// EdgeID identifies a single edge of a multigraph.
+type EdgeID int
+
+// IsMultigraph checks whether the graph allows parallel edges.
+func (g *Graph[K]) IsMultigraph() bool {
+	return g.multiEdges
+}
+
+// AllowsSelfLoops checks whether the graph allows self-loops.
+func (g *Graph[K]) AllowsSelfLoops() bool {
+	return g.selfLoops
+}
+
+// Multiplicity returns the number of edges between node `a` and node `b`,
+// which is never more than 1 unless the graph is a multigraph.
+func (g *Graph[K]) Multiplicity(a, b K) int {
+	count := countOf(g.nodes[a], b)
+	if a == b {
+		// A self-loop is listed once for each end
+		count /= 2
+	}
+
+	return count
+}
+
+// AddEdgeWithID adds an edge between node `a` and node `b` the same way
+// AddEdge does, returning the id of the new edge. Only multigraphs track edge
+// ids, so on a simple graph an error is returned.
+func (g *Graph[K]) AddEdgeWithID(a, b K) (EdgeID, error) {
+	if !g.multiEdges {
+		return 0, fmt.Errorf("edge ids are only tracked in multigraphs")
+	}
+
+	err := g.AddEdge(a, b)
+	if err != nil {
+		return 0, err
+	}
+
+	ids := g.edgeIDs[a][b]
+	return ids[len(ids)-1], nil
+}
+
+// EdgeIDs returns the ids of the parallel edges between node `a` and node `b`
+// in the order they were added. Only multigraphs track edge ids, so on a
+// simple graph an error is returned.
+func (g *Graph[K]) EdgeIDs(a, b K) ([]EdgeID, error) {
+	if !g.multiEdges {
+		return nil, fmt.Errorf("edge ids are only tracked in multigraphs")
+	}
+	if g.nodes[a] == nil {
+		return nil, fmt.Errorf("node %v does not exist", a)
+	}
+	if g.nodes[b] == nil {
+		return nil, fmt.Errorf("node %v does not exist", b)
+	}
+
+	return slices.Clone(g.edgeIDs[a][b]), nil
+}
+
+// EdgeEnds returns the nodes the edge of the given id connects.
+func (g *Graph[K]) EdgeEnds(id EdgeID) (Edge[K], error) {
+	edge, ok := g.edgeEnds[id]
+	if !ok {
+		return Edge[K]{}, fmt.Errorf("edge %d does not exist", id)
+	}
+
+	return edge, nil
+}
+
+// RemoveEdgeByID removes a single edge of a multigraph provided its id,
+// keeping any other parallel edges between the same nodes. Only returns error
+// if the edge already does not exist.
+func (g *Graph[K]) RemoveEdgeByID(id EdgeID) error {
+	edge, ok := g.edgeEnds[id]
+	if !ok {
+		return fmt.Errorf("edge %d does not exist", id)
+	}
+	a, b := edge.A, edge.B
+
+	g.nodes[a] = slices.Delete(g.nodes[a], slices.Index(g.nodes[a], b), slices.Index(g.nodes[a], b)+1)
+	g.nodes[b] = slices.Delete(g.nodes[b], slices.Index(g.nodes[b], a), slices.Index(g.nodes[b], a)+1)
+
+	delete(g.edgeEnds, id)
+	g.edgeIDs[a][b] = slices.DeleteFunc(g.edgeIDs[a][b], func(other EdgeID) bool {
+		return other == id
+	})
+	if a != b {
+		g.edgeIDs[b][a] = slices.DeleteFunc(g.edgeIDs[b][a], func(other EdgeID) bool {
+			return other == id
+		})
+	}
+
+	// The last parallel edge takes the shared weight and attributes with it
+	if g.Multiplicity(a, b) == 0 {
+		delete(g.weights[a], b)
+		delete(g.weights[b], a)
+		g.removeEdgeAttrs(a, b)
+		g.splitComponent(a, b)
+	}
+
+	return nil
+}
+
+// assignEdgeID gives the latest edge between node `a` and node `b` an id.
+func (g *Graph[K]) assignEdgeID(a, b K) {
+	if g.edgeIDs == nil {
+		g.edgeIDs = make(map[K]map[K][]EdgeID)
+		g.edgeEnds = make(map[EdgeID]Edge[K])
+	}
+	if g.edgeIDs[a] == nil {
+		g.edgeIDs[a] = make(map[K][]EdgeID)
+	}
+	if g.edgeIDs[b] == nil {
+		g.edgeIDs[b] = make(map[K][]EdgeID)
+	}
+
+	id := g.nextEdgeID
+	g.nextEdgeID++
+
+	g.edgeEnds[id] = Edge[K]{A: a, B: b}
+	g.edgeIDs[a][b] = append(g.edgeIDs[a][b], id)
+	if a != b {
+		g.edgeIDs[b][a] = append(g.edgeIDs[b][a], id)
+	}
+}
+
+// removeEdgeIDs forgets the ids of every edge between node `a` and node `b`.
+func (g *Graph[K]) removeEdgeIDs(a, b K) {
+	for _, id := range g.edgeIDs[a][b] {
+		delete(g.edgeEnds, id)
+	}
+
+	delete(g.edgeIDs[a], b)
+	delete(g.edgeIDs[b], a)
+}
+
+func countOf[K comparable](s []K, v K) int {
+	count := 0
+	for _, item := range s {
+		if item == v {
+			count++
+		}
+	}
+
+	return count
+}
@@ -0,0 +1,314 @@
+package graph
+
+import (
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestNew_EdgePolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		nodes   map[int][]int
+		opts    []Option
+		wantErr bool
+	}{
+		{
+			name: "Should reject parallel edges in a simple graph",
+			nodes: map[int][]int{
+				0: {1, 1},
+				1: {0, 0},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should accept parallel edges in a multigraph",
+			nodes: map[int][]int{
+				0: {1, 1},
+				1: {0, 0},
+			},
+			opts: []Option{WithMultiEdges()},
+		},
+		{
+			name: "Should reject parallel edges listed more times on one side",
+			nodes: map[int][]int{
+				0: {1, 1},
+				1: {0},
+			},
+			opts:    []Option{WithMultiEdges()},
+			wantErr: true,
+		},
+		{
+			name: "Should reject self-loops by default",
+			nodes: map[int][]int{
+				0: {0, 0},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should accept self-loops if allowed",
+			nodes: map[int][]int{
+				0: {0, 0},
+			},
+			opts: []Option{WithSelfLoops()},
+		},
+		{
+			name: "Should reject a self-loop listed once",
+			nodes: map[int][]int{
+				0: {0},
+			},
+			opts:    []Option{WithSelfLoops()},
+			wantErr: true,
+		},
+		{
+			name: "Should reject two self-loops on a node in a simple graph",
+			nodes: map[int][]int{
+				0: {0, 0, 0, 0},
+			},
+			opts:    []Option{WithSelfLoops()},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(tt.nodes, tt.opts...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("New() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGraph_AddEdge_EdgePolicy(t *testing.T) {
+	type args struct {
+		a int
+		b int
+	}
+	tests := []struct {
+		name      string
+		opts      []Option
+		args      args
+		wantNodes map[int][]int
+		wantErr   bool
+	}{
+		{
+			name: "Should reject an existing edge in a simple graph",
+			args: args{a: 1, b: 0},
+			wantNodes: map[int][]int{
+				0: {1},
+				1: {0},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should add a parallel edge in a multigraph",
+			opts: []Option{WithMultiEdges()},
+			args: args{a: 1, b: 0},
+			wantNodes: map[int][]int{
+				0: {1, 1},
+				1: {0, 0},
+			},
+		},
+		{
+			name: "Should reject a self-loop by default",
+			opts: []Option{WithMultiEdges()},
+			args: args{a: 0, b: 0},
+			wantNodes: map[int][]int{
+				0: {1},
+				1: {0},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should add a self-loop if allowed",
+			opts: []Option{WithSelfLoops()},
+			args: args{a: 0, b: 0},
+			wantNodes: map[int][]int{
+				0: {0, 0, 1},
+				1: {0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := New(map[int][]int{0: {1}, 1: {0}}, tt.opts...)
+			if err != nil {
+				t.Fatalf("New() error = %v", err)
+			}
+
+			if err := g.AddEdge(tt.args.a, tt.args.b); (err != nil) != tt.wantErr {
+				t.Errorf("Graph.AddEdge() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			sortAdjacency(g.nodes)
+
+			if !reflect.DeepEqual(g.nodes, tt.wantNodes) {
+				t.Errorf("Graph.AddEdge() Graph.nodes = %v, want %v", g.nodes, tt.wantNodes)
+			}
+		})
+	}
+}
+
+func TestGraph_AddNode_EdgePolicy(t *testing.T) {
+	g, err := New(map[int][]int{0: {}})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	if err := g.AddNode(1, []int{0, 0}); err == nil {
+		t.Errorf("Graph.AddNode() expected error for parallel connections in a simple graph")
+	}
+
+	want := map[int][]int{0: {}}
+	if !reflect.DeepEqual(g.nodes, want) {
+		t.Errorf("Graph.AddNode() Graph.nodes = %v, want %v", g.nodes, want)
+	}
+}
+
+func TestGraph_Multiplicity(t *testing.T) {
+	g, err := New(map[int][]int{
+		0: {1, 1, 1, 2},
+		1: {0, 0, 0, 1, 1},
+		2: {0},
+	}, WithMultiEdges(), WithSelfLoops())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	tests := []struct {
+		a    int
+		b    int
+		want int
+	}{
+		{a: 0, b: 1, want: 3},
+		{a: 1, b: 0, want: 3},
+		{a: 0, b: 2, want: 1},
+		{a: 1, b: 1, want: 1},
+		{a: 1, b: 2, want: 0},
+		{a: 5, b: 0, want: 0},
+	}
+	for _, tt := range tests {
+		if got := g.Multiplicity(tt.a, tt.b); got != tt.want {
+			t.Errorf("Graph.Multiplicity(%d, %d) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestGraph_RemoveEdgeByID(t *testing.T) {
+	g, err := New(map[int][]int{
+		0: {1},
+		1: {0},
+		2: {},
+	}, WithMultiEdges(), WithSelfLoops())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	second, err := g.AddEdgeWithID(0, 1)
+	if err != nil {
+		t.Fatalf("Graph.AddEdgeWithID() error = %v", err)
+	}
+	loop, err := g.AddEdgeWithID(2, 2)
+	if err != nil {
+		t.Fatalf("Graph.AddEdgeWithID() error = %v", err)
+	}
+
+	ids, err := g.EdgeIDs(1, 0)
+	if err != nil {
+		t.Fatalf("Graph.EdgeIDs() error = %v", err)
+	}
+	if len(ids) != 2 || ids[1] != second {
+		t.Fatalf("Graph.EdgeIDs(1, 0) = %v, want 2 ids ending with %v", ids, second)
+	}
+
+	if err := g.RemoveEdgeByID(ids[0]); err != nil {
+		t.Fatalf("Graph.RemoveEdgeByID() error = %v", err)
+	}
+	if err := g.RemoveEdgeByID(loop); err != nil {
+		t.Fatalf("Graph.RemoveEdgeByID() error = %v", err)
+	}
+	if err := g.RemoveEdgeByID(loop); err == nil {
+		t.Errorf("Graph.RemoveEdgeByID() expected error for an already removed edge")
+	}
+
+	want := map[int][]int{0: {1}, 1: {0}, 2: {}}
+	if !reflect.DeepEqual(g.nodes, want) {
+		t.Errorf("Graph.RemoveEdgeByID() Graph.nodes = %v, want %v", g.nodes, want)
+	}
+
+	ids, _ = g.EdgeIDs(0, 1)
+	if !slices.Equal(ids, []EdgeID{second}) {
+		t.Errorf("Graph.EdgeIDs(0, 1) = %v, want [%v]", ids, second)
+	}
+	if edge, err := g.EdgeEnds(second); err != nil || edge != (Edge[int]{A: 0, B: 1}) {
+		t.Errorf("Graph.EdgeEnds(%v) = %v, %v, want {0 1}", second, edge, err)
+	}
+
+	if err := g.RemoveNode(1); err != nil {
+		t.Fatalf("Graph.RemoveNode() error = %v", err)
+	}
+	if _, err := g.EdgeEnds(second); err == nil {
+		t.Errorf("Graph.EdgeEnds() expected error for an edge of a removed node")
+	}
+}
+
+func TestGraph_AddEdgeWithID_SimpleGraph(t *testing.T) {
+	g, err := New(map[int][]int{0: {}, 1: {}})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	if _, err := g.AddEdgeWithID(0, 1); err == nil {
+		t.Errorf("Graph.AddEdgeWithID() expected error on a simple graph")
+	}
+	if g.Multiplicity(0, 1) != 0 {
+		t.Errorf("Graph.AddEdgeWithID() added an edge to a simple graph")
+	}
+}
+
+func TestGraph_AddWeightedEdge_MultiEdges(t *testing.T) {
+	g, err := New(map[int][]int{0: {}, 1: {}}, WithMultiEdges())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	if err := g.AddWeightedEdge(0, 1, 5); err != nil {
+		t.Fatalf("Graph.AddWeightedEdge() error = %v", err)
+	}
+	if err := g.AddWeightedEdge(1, 0, 1); err == nil {
+		t.Errorf("Graph.AddWeightedEdge() expected error for a parallel edge of a different weight")
+	}
+	if err := g.AddWeightedEdge(1, 0, 5); err != nil {
+		t.Errorf("Graph.AddWeightedEdge() error = %v for a parallel edge of the same weight", err)
+	}
+
+	if got := g.Multiplicity(0, 1); got != 2 {
+		t.Errorf("Graph.Multiplicity(0, 1) = %v, want 2", got)
+	}
+	if ids, _ := g.EdgeIDs(0, 1); len(ids) != 2 {
+		t.Errorf("Graph.EdgeIDs(0, 1) = %v, want 2 ids", ids)
+	}
+	want := map[int]map[int]float64{0: {1: 5}, 1: {0: 5}}
+	if !reflect.DeepEqual(g.weights, want) {
+		t.Errorf("Graph.AddWeightedEdge() Graph.weights = %v, want %v", g.weights, want)
+	}
+
+	if _, err := ReadEdgeList(strings.NewReader("0 1 5\n1 0 1\n"), strconv.Atoi, WithMultiEdges()); err == nil {
+		t.Errorf("ReadEdgeList() expected error for parallel edges of different weights")
+	}
+}
+
+func TestGraph_AddWeightedEdge_SimpleGraph(t *testing.T) {
+	g, err := New(map[int][]int{0: {1}, 1: {0}})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	// A simple graph has no parallel edges to compare weights with
+	err = g.AddWeightedEdge(0, 1, 3)
+	if err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Errorf("Graph.AddWeightedEdge() error = %v, want the edge to already exist", err)
+	}
+}
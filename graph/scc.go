@@ -0,0 +1,117 @@
+package graph
+
+import "slices"
+
+// StronglyConnectedComponents returns the strongly connected components of
+// the digraph, each one being the largest set of nodes that can all reach each
+// other following arcs, using Tarjan's algorithm in linear time. Components
+// are ordered topologically, every arc between two components goes from an
+// earlier one to a later one. One-way adjacency, such as a call graph, can be
+// loaded with NewDigraph.
+func (d *Digraph[K]) StronglyConnectedComponents() []NodeSet[K] {
+	index := make(map[K]int, len(d.out))
+	low := make(map[K]int, len(d.out))
+	onStack := make(map[K]bool)
+	var stack []K
+	var components []NodeSet[K]
+
+	var visit func(node K)
+	visit = func(node K) {
+		index[node] = len(index)
+		low[node] = index[node]
+		stack = append(stack, node)
+		onStack[node] = true
+
+		for _, successor := range d.out[node] {
+			if _, seen := index[successor]; !seen {
+				visit(successor)
+				low[node] = min(low[node], low[successor])
+			} else if onStack[successor] {
+				low[node] = min(low[node], index[successor])
+			}
+		}
+
+		// Nothing reachable from the node leads back above it, so it is the
+		// first node of its component to be found and the rest of the
+		// component sits above it on the stack
+		if low[node] == index[node] {
+			component := make(NodeSet[K])
+			for {
+				top := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[top] = false
+				component[top] = struct{}{}
+
+				if top == node {
+					break
+				}
+			}
+			components = append(components, component)
+		}
+	}
+
+	nodes := make([]K, 0, len(d.out))
+	for node := range d.out {
+		nodes = append(nodes, node)
+	}
+	slices.SortFunc(nodes, compareNodes)
+
+	for _, node := range nodes {
+		if _, seen := index[node]; !seen {
+			visit(node)
+		}
+	}
+
+	// Tarjan's algorithm finds components in reverse topological order
+	slices.Reverse(components)
+
+	return components
+}
+
+// Condensation returns the strongly connected components of the digraph,
+// ordered as by StronglyConnectedComponents, along with the condensation of
+// the digraph, a digraph with node i standing for components[i] and an arc
+// between two components if any arc goes between their nodes. The
+// condensation has no cycles, and its arcs always go from a lower node to a
+// higher one.
+func (d *Digraph[K]) Condensation() (*Digraph[int], []NodeSet[K]) {
+	components := d.StronglyConnectedComponents()
+
+	componentOf := make(map[K]int, len(d.out))
+	for i, component := range components {
+		for node := range component {
+			componentOf[node] = i
+		}
+	}
+
+	dag := Digraph[int]{
+		out: make(map[int][]int, len(components)),
+		in:  make(map[int][]int, len(components)),
+	}
+	for i := range components {
+		dag.out[i] = []int{}
+		dag.in[i] = []int{}
+	}
+
+	seen := make(map[[2]int]bool)
+	for node, successors := range d.out {
+		from := componentOf[node]
+		for _, successor := range successors {
+			to := componentOf[successor]
+			if from == to || seen[[2]int{from, to}] {
+				continue
+			}
+			seen[[2]int{from, to}] = true
+
+			dag.out[from] = append(dag.out[from], to)
+			dag.in[to] = append(dag.in[to], from)
+		}
+	}
+
+	for i := range components {
+		slices.Sort(dag.out[i])
+		slices.Sort(dag.in[i])
+	}
+
+	return &dag, components
+}
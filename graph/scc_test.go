@@ -0,0 +1,104 @@
+package graph
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// callGraph has functions 0, 1 and 2 calling each other in a loop, 3 and 4
+// calling each other, and 5 only called.
+func callGraph() map[int][]int {
+	return map[int][]int{
+		0: {1},
+		1: {2, 3},
+		2: {0, 5},
+		3: {4},
+		4: {3, 5},
+		5: {},
+	}
+}
+
+func TestDigraph_StronglyConnectedComponents(t *testing.T) {
+	tests := []struct {
+		name string
+		arcs map[int][]int
+		want [][]int
+	}{
+		{
+			name: "Should group nodes reaching each other in topological order",
+			arcs: callGraph(),
+			want: [][]int{{0, 1, 2}, {3, 4}, {5}},
+		},
+		{
+			name: "Should put every node of an acyclic digraph in a component of its own",
+			arcs: map[int][]int{
+				0: {2},
+				1: {0},
+				2: {},
+			},
+			want: [][]int{{1}, {0}, {2}},
+		},
+		{
+			name: "Should return no components for an empty digraph",
+			arcs: map[int][]int{},
+			want: [][]int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := NewDigraph(tt.arcs)
+			if err != nil {
+				t.Fatalf("NewDigraph() error = %v", err)
+			}
+
+			got := [][]int{}
+			for _, component := range d.StronglyConnectedComponents() {
+				got = append(got, slices.Sorted(maps.Keys(component)))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Digraph.StronglyConnectedComponents() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDigraph_Condensation(t *testing.T) {
+	d, err := NewDigraph(callGraph())
+	if err != nil {
+		t.Fatalf("NewDigraph() error = %v", err)
+	}
+
+	dag, components := d.Condensation()
+
+	var got [][]int
+	for _, component := range components {
+		got = append(got, slices.Sorted(maps.Keys(component)))
+	}
+	if want := [][]int{{0, 1, 2}, {3, 4}, {5}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Digraph.Condensation() components = %v, want %v", got, want)
+	}
+
+	wantOut := map[int][]int{
+		0: {1, 2},
+		1: {2},
+		2: {},
+	}
+	if !reflect.DeepEqual(dag.out, wantOut) {
+		t.Errorf("Digraph.Condensation() Digraph.out = %v, want %v", dag.out, wantOut)
+	}
+
+	wantIn := map[int][]int{
+		0: {},
+		1: {0},
+		2: {0, 1},
+	}
+	if !reflect.DeepEqual(dag.in, wantIn) {
+		t.Errorf("Digraph.Condensation() Digraph.in = %v, want %v", dag.in, wantIn)
+	}
+
+	if dag.CycleExists() {
+		t.Errorf("Digraph.Condensation() has a cycle")
+	}
+}
@@ -0,0 +1,145 @@
+package graph
+
+import (
+	"maps"
+	"slices"
+)
+
+// Edge is an edge between node A and node B.
+type Edge[K comparable] struct {
+	A K
+	B K
+}
+
+// Clone returns a deep copy of the graph, so that changes to either graph do
+// not affect the other one. Attribute values themselves are copied as they
+// are, so values holding references are shared.
+func (g *Graph[K]) Clone() *Graph[K] {
+	clone := Graph[K]{
+		nodes:      cloneAdjacency(g.nodes),
+		multiEdges: g.multiEdges,
+		selfLoops:  g.selfLoops,
+		nextEdgeID: g.nextEdgeID,
+	}
+
+	if g.weights != nil {
+		clone.weights = make(map[K]map[K]float64, len(g.weights))
+		for node, neighborWeights := range g.weights {
+			clone.weights[node] = make(map[K]float64, len(neighborWeights))
+			for neighbor, weight := range neighborWeights {
+				clone.weights[node][neighbor] = weight
+			}
+		}
+	}
+
+	if g.nodeAttrs != nil {
+		clone.nodeAttrs = make(map[K]map[string]any, len(g.nodeAttrs))
+		for node, attrs := range g.nodeAttrs {
+			clone.nodeAttrs[node] = maps.Clone(attrs)
+		}
+	}
+
+	if g.edgeAttrs != nil {
+		clone.edgeAttrs = make(map[K]map[K]map[string]any, len(g.edgeAttrs))
+		for node, neighborAttrs := range g.edgeAttrs {
+			clone.edgeAttrs[node] = make(map[K]map[string]any, len(neighborAttrs))
+			for neighbor, attrs := range neighborAttrs {
+				clone.edgeAttrs[node][neighbor] = maps.Clone(attrs)
+			}
+		}
+	}
+
+	if g.edgeIDs != nil {
+		clone.edgeIDs = make(map[K]map[K][]EdgeID, len(g.edgeIDs))
+		for node, neighborIDs := range g.edgeIDs {
+			clone.edgeIDs[node] = make(map[K][]EdgeID, len(neighborIDs))
+			for neighbor, ids := range neighborIDs {
+				clone.edgeIDs[node][neighbor] = slices.Clone(ids)
+			}
+		}
+
+		clone.edgeEnds = make(map[EdgeID]Edge[K], len(g.edgeEnds))
+		for id, edge := range g.edgeEnds {
+			clone.edgeEnds[id] = edge
+		}
+	}
+
+	if g.components != nil {
+		clone.components = g.components.clone()
+	}
+
+	return &clone
+}
+
+func cloneAdjacency[K comparable](nodes map[K][]K) map[K][]K {
+	clone := make(map[K][]K, len(nodes))
+	for node, neighbors := range nodes {
+		clone[node] = slices.Clone(neighbors)
+		if clone[node] == nil {
+			clone[node] = []K{}
+		}
+	}
+
+	return clone
+}
+
+// GraphDiff describes the changes that turn one graph into another. Edges are
+// listed with the smaller node first, a parallel edge is listed once for every
+// copy added or removed.
+type GraphDiff[K comparable] struct {
+	AddedNodes   []K
+	RemovedNodes []K
+	AddedEdges   []Edge[K]
+	RemovedEdges []Edge[K]
+}
+
+// Empty checks whether the graphs compared were the same.
+func (d GraphDiff[K]) Empty() bool {
+	return len(d.AddedNodes) == 0 && len(d.RemovedNodes) == 0 && len(d.AddedEdges) == 0 && len(d.RemovedEdges) == 0
+}
+
+// Diff compares graph `a` to graph `b`, reporting the nodes and edges `b` has
+// that `a` doesn't as added, and the other way around as removed. Weights are
+// not compared.
+func Diff[K comparable](a, b *Graph[K]) GraphDiff[K] {
+	var diff GraphDiff[K]
+
+	for _, node := range b.sortedNodes() {
+		if _, ok := a.nodes[node]; !ok {
+			diff.AddedNodes = append(diff.AddedNodes, node)
+		}
+	}
+	for _, node := range a.sortedNodes() {
+		if _, ok := b.nodes[node]; !ok {
+			diff.RemovedNodes = append(diff.RemovedNodes, node)
+		}
+	}
+
+	edgesA, edgesB := a.sortedEdges(), b.sortedEdges()
+
+	countA := make(map[[2]K]int, len(edgesA))
+	for _, edge := range edgesA {
+		countA[edge]++
+	}
+	countB := make(map[[2]K]int, len(edgesB))
+	for _, edge := range edgesB {
+		countB[edge]++
+	}
+
+	for _, edge := range edgesB {
+		if countA[edge] > 0 {
+			countA[edge]--
+			continue
+		}
+		diff.AddedEdges = append(diff.AddedEdges, Edge[K]{A: edge[0], B: edge[1]})
+	}
+	for _, edge := range edgesA {
+		if countB[edge] > 0 {
+			countB[edge]--
+			continue
+		}
+		diff.RemovedEdges = append(diff.RemovedEdges, Edge[K]{A: edge[0], B: edge[1]})
+	}
+
+	return diff
+}
@@ -0,0 +1,177 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGraph_Clone(t *testing.T) {
+	g := IntGraph{
+		nodes: map[int][]int{
+			0: {1},
+			1: {0},
+			2: {},
+		},
+		weights: map[int]map[int]float64{
+			0: {1: 2},
+			1: {0: 2},
+		},
+	}
+
+	clone := g.Clone()
+	if !reflect.DeepEqual(clone.nodes, g.nodes) || !reflect.DeepEqual(clone.weights, g.weights) {
+		t.Fatalf("Graph.Clone() = %v %v, want %v %v", clone.nodes, clone.weights, g.nodes, g.weights)
+	}
+
+	if err := clone.AddWeightedEdge(1, 2, 5); err != nil {
+		t.Fatalf("Graph.AddWeightedEdge() error = %v", err)
+	}
+	if err := g.RemoveEdge(0, 1); err != nil {
+		t.Fatalf("Graph.RemoveEdge() error = %v", err)
+	}
+
+	wantOriginal := map[int][]int{0: {}, 1: {}, 2: {}}
+	if !reflect.DeepEqual(g.nodes, wantOriginal) {
+		t.Errorf("original Graph.nodes = %v, want %v", g.nodes, wantOriginal)
+	}
+
+	wantClone := map[int][]int{0: {1}, 1: {0, 2}, 2: {1}}
+	if !reflect.DeepEqual(clone.nodes, wantClone) {
+		t.Errorf("cloned Graph.nodes = %v, want %v", clone.nodes, wantClone)
+	}
+	if weight, _ := clone.Weight(0, 1); weight != 2 {
+		t.Errorf("cloned Graph.Weight(0, 1) = %v, want 2", weight)
+	}
+}
+
+func TestNew_CopiesNodes(t *testing.T) {
+	nodes := map[int][]int{
+		0: {1},
+		1: {0},
+	}
+
+	g, err := New(nodes)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	nodes[0][0] = 5
+	nodes[2] = []int{}
+	if err := g.AddNode(3, []int{0}); err != nil {
+		t.Fatalf("Graph.AddNode() error = %v", err)
+	}
+
+	want := map[int][]int{0: {1, 3}, 1: {0}, 3: {0}}
+	if !reflect.DeepEqual(g.nodes, want) {
+		t.Errorf("Graph.nodes = %v, want %v", g.nodes, want)
+	}
+	if len(nodes) != 3 || len(nodes[0]) != 1 {
+		t.Errorf("provided nodes changed by the graph: %v", nodes)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name string
+		a    IntGraph
+		b    IntGraph
+		want GraphDiff[int]
+	}{
+		{
+			name: "Should report added and removed nodes and edges",
+			a: IntGraph{
+				nodes: map[int][]int{
+					0: {1, 2},
+					1: {0},
+					2: {0},
+				},
+			},
+			b: IntGraph{
+				nodes: map[int][]int{
+					0: {2},
+					2: {0, 3},
+					3: {2, 4},
+					4: {3},
+				},
+			},
+			want: GraphDiff[int]{
+				AddedNodes:   []int{3, 4},
+				RemovedNodes: []int{1},
+				AddedEdges:   []Edge[int]{{A: 2, B: 3}, {A: 3, B: 4}},
+				RemovedEdges: []Edge[int]{{A: 0, B: 1}},
+			},
+		},
+		{
+			name: "Should count parallel edges",
+			a: IntGraph{
+				nodes: map[int][]int{
+					0: {1, 1, 1},
+					1: {0, 0, 0},
+				},
+			},
+			b: IntGraph{
+				nodes: map[int][]int{
+					0: {1},
+					1: {0},
+				},
+			},
+			want: GraphDiff[int]{
+				RemovedEdges: []Edge[int]{{A: 0, B: 1}, {A: 0, B: 1}},
+			},
+		},
+		{
+			name: "Should report nothing for equal graphs",
+			a: IntGraph{
+				nodes: map[int][]int{
+					0: {1},
+					1: {0},
+				},
+			},
+			b: IntGraph{
+				nodes: map[int][]int{
+					1: {0},
+					0: {1},
+				},
+			},
+			want: GraphDiff[int]{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Diff(&tt.a, &tt.b)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Diff() = %+v, want %+v", got, tt.want)
+			}
+			if got.Empty() != tt.want.Empty() {
+				t.Errorf("GraphDiff.Empty() = %v, want %v", got.Empty(), tt.want.Empty())
+			}
+		})
+	}
+}
+
+func TestGraph_Clone_Multigraph(t *testing.T) {
+	g, err := New(map[int][]int{
+		0: {1, 1},
+		1: {0, 0},
+	}, WithMultiEdges())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	clone := g.Clone()
+
+	ids, _ := g.EdgeIDs(0, 1)
+	if err := clone.RemoveEdgeByID(ids[0]); err != nil {
+		t.Fatalf("cloned Graph.RemoveEdgeByID() error = %v", err)
+	}
+	if err := clone.AddEdge(0, 1); err != nil {
+		t.Errorf("cloned Graph.AddEdge() error = %v, want parallel edges allowed", err)
+	}
+
+	if got := g.Multiplicity(0, 1); got != 2 {
+		t.Errorf("original Graph.Multiplicity(0, 1) = %v, want 2", got)
+	}
+	if gotIDs, _ := g.EdgeIDs(0, 1); !reflect.DeepEqual(gotIDs, ids) {
+		t.Errorf("original Graph.EdgeIDs(0, 1) = %v, want %v", gotIDs, ids)
+	}
+}
@@ -0,0 +1,138 @@
+package graph
+
+import (
+	"cmp"
+	"container/heap"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// CycleError is returned by operations that require an acyclic digraph when a
+// cycle is found. Cycle holds the nodes of one such cycle in arc order, the
+// last node pointing back to the first one.
+type CycleError[K comparable] struct {
+	Cycle []K
+}
+
+func (e *CycleError[K]) Error() string {
+	nodes := make([]string, 0, len(e.Cycle)+1)
+	for _, node := range e.Cycle {
+		nodes = append(nodes, fmt.Sprint(node))
+	}
+	if len(e.Cycle) > 0 {
+		nodes = append(nodes, fmt.Sprint(e.Cycle[0]))
+	}
+
+	return fmt.Sprintf("digraph contains a cycle: %s", strings.Join(nodes, " -> "))
+}
+
+// TopologicalSort orders the nodes of the digraph so that every arc goes from
+// an earlier node to a later one, breaking ties by the natural order of the
+// nodes. If the digraph has a cycle, a *CycleError naming its nodes is
+// returned.
+func TopologicalSort[K cmp.Ordered](d *Digraph[K]) ([]K, error) {
+	return d.TopologicalSortFunc(cmp.Compare[K])
+}
+
+// TopologicalSortFunc orders the nodes of the digraph so that every arc goes
+// from an earlier node to a later one using Kahn's algorithm. Whenever several
+// nodes are ready at once, the smallest one according to `cmp` goes first, so
+// the result is the same for the same digraph. If the digraph has a cycle, a
+// *CycleError naming its nodes is returned.
+func (d *Digraph[K]) TopologicalSortFunc(cmp func(a, b K) int) ([]K, error) {
+	inDegree := make(map[K]int, len(d.in))
+	ready := &orderedQueue[K]{cmp: cmp}
+	for node, predecessors := range d.in {
+		inDegree[node] = len(predecessors)
+		if len(predecessors) == 0 {
+			ready.nodes = append(ready.nodes, node)
+		}
+	}
+	heap.Init(ready)
+
+	order := make([]K, 0, len(d.out))
+	for ready.Len() > 0 {
+		node := heap.Pop(ready).(K)
+		order = append(order, node)
+
+		for _, successor := range d.out[node] {
+			inDegree[successor]--
+			if inDegree[successor] == 0 {
+				heap.Push(ready, successor)
+			}
+		}
+	}
+
+	if len(order) < len(d.out) {
+		return nil, &CycleError[K]{Cycle: d.remainingCycle(inDegree, cmp)}
+	}
+
+	return order, nil
+}
+
+// remainingCycle finds a cycle among the nodes Kahn's algorithm could not
+// order. Every such node still has a predecessor that was not ordered either,
+// so walking predecessors from any of them is bound to repeat a node.
+func (d *Digraph[K]) remainingCycle(inDegree map[K]int, cmp func(a, b K) int) []K {
+	var remaining []K
+	for node, degree := range inDegree {
+		if degree > 0 {
+			remaining = append(remaining, node)
+		}
+	}
+	slices.SortFunc(remaining, cmp)
+
+	position := make(map[K]int)
+	var walk []K
+	for node := remaining[0]; ; {
+		if start, ok := position[node]; ok {
+			cycle := walk[start:]
+			slices.Reverse(cycle)
+
+			// Start the cycle from its smallest node
+			first := slices.Index(cycle, slices.MinFunc(cycle, cmp))
+			return slices.Concat(cycle[first:], cycle[:first])
+		}
+
+		position[node] = len(walk)
+		walk = append(walk, node)
+
+		var next []K
+		for _, predecessor := range d.in[node] {
+			if inDegree[predecessor] > 0 {
+				next = append(next, predecessor)
+			}
+		}
+		node = slices.MinFunc(next, cmp)
+	}
+}
+
+// orderedQueue is a binary min-heap of nodes ordered by cmp, implementing
+// heap.Interface.
+type orderedQueue[K comparable] struct {
+	nodes []K
+	cmp   func(a, b K) int
+}
+
+func (q orderedQueue[K]) Len() int {
+	return len(q.nodes)
+}
+
+func (q orderedQueue[K]) Less(i, j int) bool {
+	return q.cmp(q.nodes[i], q.nodes[j]) < 0
+}
+
+func (q orderedQueue[K]) Swap(i, j int) {
+	q.nodes[i], q.nodes[j] = q.nodes[j], q.nodes[i]
+}
+
+func (q *orderedQueue[K]) Push(x any) {
+	q.nodes = append(q.nodes, x.(K))
+}
+
+func (q *orderedQueue[K]) Pop() any {
+	node := q.nodes[len(q.nodes)-1]
+	q.nodes = q.nodes[:len(q.nodes)-1]
+	return node
+}
@@ -0,0 +1,126 @@
+package graph
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTopologicalSort(t *testing.T) {
+	tests := []struct {
+		name      string
+		arcs      map[int][]int
+		want      []int
+		wantCycle []int
+	}{
+		{
+			name: "Should order nodes so every arc goes forward",
+			arcs: map[int][]int{
+				0: {3},
+				1: {3, 4},
+				2: {4},
+				3: {5},
+				4: {5},
+				5: {},
+			},
+			want: []int{0, 1, 2, 3, 4, 5},
+		},
+		{
+			name: "Should pick the smallest ready node first",
+			arcs: map[int][]int{
+				5: {2},
+				4: {0, 1},
+				3: {1},
+				2: {3},
+				1: {},
+				0: {},
+			},
+			want: []int{4, 0, 5, 2, 3, 1},
+		},
+		{
+			name: "Should return the nodes forming a cycle",
+			arcs: map[int][]int{
+				0: {1},
+				1: {2},
+				2: {3},
+				3: {1, 4},
+				4: {},
+			},
+			wantCycle: []int{1, 2, 3},
+		},
+		{
+			name: "Should return a self-loop as a cycle",
+			arcs: map[int][]int{
+				0: {1},
+				1: {1},
+			},
+			wantCycle: []int{1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := NewDigraph(tt.arcs)
+			if err != nil {
+				t.Fatalf("NewDigraph() error = %v", err)
+			}
+
+			got, err := TopologicalSort(d)
+
+			var cycleErr *CycleError[int]
+			if errors.As(err, &cycleErr) {
+				if !reflect.DeepEqual(cycleErr.Cycle, tt.wantCycle) {
+					t.Errorf("TopologicalSort() cycle = %v, want %v", cycleErr.Cycle, tt.wantCycle)
+				}
+				return
+			}
+			if err != nil || tt.wantCycle != nil {
+				t.Errorf("TopologicalSort() error = %v, wantCycle %v", err, tt.wantCycle)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TopologicalSort() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDigraph_TopologicalSortFunc(t *testing.T) {
+	d, err := NewDigraph(map[string][]string{
+		"app":    {"server"},
+		"server": {"db", "cache"},
+		"db":     {},
+		"cache":  {},
+		"config": {"app"},
+	})
+	if err != nil {
+		t.Fatalf("NewDigraph() error = %v", err)
+	}
+
+	// Order by length first so the comparator clearly drives the ties
+	byLength := func(a, b string) int {
+		if len(a) != len(b) {
+			return len(a) - len(b)
+		}
+		return strings.Compare(a, b)
+	}
+
+	got, err := d.TopologicalSortFunc(byLength)
+	if err != nil {
+		t.Fatalf("Digraph.TopologicalSortFunc() error = %v", err)
+	}
+
+	want := []string{"config", "app", "server", "db", "cache"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Digraph.TopologicalSortFunc() = %v, want %v", got, want)
+	}
+}
+
+func TestCycleError_Error(t *testing.T) {
+	err := &CycleError[string]{Cycle: []string{"a", "b", "c"}}
+
+	want := "digraph contains a cycle: a -> b -> c -> a"
+	if got := err.Error(); got != want {
+		t.Errorf("CycleError.Error() = %q, want %q", got, want)
+	}
+}
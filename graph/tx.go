@@ -0,0 +1,247 @@
+package graph
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// Tx is a batch of mutations to a graph that is applied atomically, either all
+// of the mutations take effect or none of them do. Mutations are only queued
+// until Commit is called.
+type Tx[K comparable] struct {
+	graph *Graph[K]
+	ops   []txOp[K]
+}
+
+type txOp[K comparable] struct {
+	name string
+	// touches returns the nodes the mutation may change, which are recorded
+	// before it is applied so that it can be undone.
+	touches func(g *Graph[K]) []K
+	apply   func(g *Graph[K]) error
+}
+
+// TxError is returned by Tx.Commit when one of the queued mutations fails,
+// naming the mutation and its position in the batch.
+type TxError struct {
+	// Index is the position of the failed mutation in the batch, starting at
+	// 0.
+	Index int
+	// Op describes the failed mutation, e.g. AddEdge(1, 2).
+	Op  string
+	Err error
+}
+
+func (e *TxError) Error() string {
+	return fmt.Sprintf("operation %d %s failed: %v", e.Index, e.Op, e.Err)
+}
+
+func (e *TxError) Unwrap() error {
+	return e.Err
+}
+
+// Begin starts a new batch of mutations to the graph.
+func (g *Graph[K]) Begin() *Tx[K] {
+	return &Tx[K]{
+		graph: g,
+	}
+}
+
+// AddNode queues adding a node, see Graph.AddNode. The connections are copied,
+// so later changes to the slice do not affect the batch.
+func (tx *Tx[K]) AddNode(nodeID K, connections []K) *Tx[K] {
+	connections = slices.Clone(connections)
+
+	return tx.queue(fmt.Sprintf("AddNode(%v, %v)", nodeID, connections), func(g *Graph[K]) []K {
+		return append([]K{nodeID}, connections...)
+	}, func(g *Graph[K]) error {
+		return g.AddNode(nodeID, connections)
+	})
+}
+
+// RemoveNode queues removing a node, see Graph.RemoveNode.
+func (tx *Tx[K]) RemoveNode(nodeID K) *Tx[K] {
+	return tx.queue(fmt.Sprintf("RemoveNode(%v)", nodeID), func(g *Graph[K]) []K {
+		return append([]K{nodeID}, g.nodes[nodeID]...)
+	}, func(g *Graph[K]) error {
+		return g.RemoveNode(nodeID)
+	})
+}
+
+// AddEdge queues adding an edge, see Graph.AddEdge.
+func (tx *Tx[K]) AddEdge(a, b K) *Tx[K] {
+	return tx.queue(fmt.Sprintf("AddEdge(%v, %v)", a, b), touchesEdge(a, b), func(g *Graph[K]) error {
+		return g.AddEdge(a, b)
+	})
+}
+
+// AddWeightedEdge queues adding a weighted edge, see Graph.AddWeightedEdge.
+func (tx *Tx[K]) AddWeightedEdge(a, b K, weight float64) *Tx[K] {
+	return tx.queue(fmt.Sprintf("AddWeightedEdge(%v, %v, %v)", a, b, weight), touchesEdge(a, b), func(g *Graph[K]) error {
+		return g.AddWeightedEdge(a, b, weight)
+	})
+}
+
+// RemoveEdge queues removing an edge, see Graph.RemoveEdge.
+func (tx *Tx[K]) RemoveEdge(a, b K) *Tx[K] {
+	return tx.queue(fmt.Sprintf("RemoveEdge(%v, %v)", a, b), touchesEdge(a, b), func(g *Graph[K]) error {
+		return g.RemoveEdge(a, b)
+	})
+}
+
+// touchesEdge returns the nodes an edge mutation touches, see txOp.touches.
+func touchesEdge[K comparable](a, b K) func(g *Graph[K]) []K {
+	return func(g *Graph[K]) []K {
+		return []K{a, b}
+	}
+}
+
+func (tx *Tx[K]) queue(name string, touches func(g *Graph[K]) []K, apply func(g *Graph[K]) error) *Tx[K] {
+	tx.ops = append(tx.ops, txOp[K]{
+		name:    name,
+		touches: touches,
+		apply:   apply,
+	})
+
+	return tx
+}
+
+// Commit applies the queued mutations in order. Before each mutation the
+// nodes it touches are recorded, so if any mutation fails the ones applied
+// before it are undone, a *TxError is returned and the graph is left
+// unchanged. Either way the queue is emptied.
+func (tx *Tx[K]) Commit() error {
+	ops := tx.ops
+	tx.ops = nil
+
+	g := tx.graph
+	nextEdgeID := g.nextEdgeID
+
+	var undo []txUndo[K]
+	for i, op := range ops {
+		saved := g.saveNodes(op.touches(g))
+
+		err := op.apply(g)
+		if err != nil {
+			// A failed mutation makes no changes itself, only the ones
+			// before it have to be undone
+			for _, done := range slices.Backward(undo) {
+				g.restoreNodes(done)
+			}
+			g.nextEdgeID = nextEdgeID
+			if g.components != nil {
+				g.rebuildComponents()
+			}
+
+			return &TxError{
+				Index: i,
+				Op:    op.name,
+				Err:   err,
+			}
+		}
+
+		undo = append(undo, saved)
+	}
+
+	return nil
+}
+
+// txUndo holds the state of the nodes a mutation touches, everything the
+// mutation may change about them, as it was before the mutation.
+type txUndo[K comparable] struct {
+	nodes    []txNode[K]
+	edgeEnds map[EdgeID]Edge[K]
+}
+
+type txNode[K comparable] struct {
+	id        K
+	exists    bool
+	neighbors []K
+	weights   map[K]float64
+	nodeAttrs map[string]any
+	edgeAttrs map[K]map[string]any
+	edgeIDs   map[K][]EdgeID
+}
+
+// saveNodes records the state of the nodes, including the ends of the edges
+// they have ids for.
+func (g *Graph[K]) saveNodes(ids []K) txUndo[K] {
+	saved := txUndo[K]{
+		nodes:    make([]txNode[K], 0, len(ids)),
+		edgeEnds: make(map[EdgeID]Edge[K]),
+	}
+
+	for _, id := range ids {
+		neighbors, exists := g.nodes[id]
+		node := txNode[K]{
+			id:        id,
+			exists:    exists,
+			neighbors: slices.Clone(neighbors),
+			weights:   maps.Clone(g.weights[id]),
+			nodeAttrs: g.nodeAttrs[id],
+			edgeAttrs: maps.Clone(g.edgeAttrs[id]),
+		}
+
+		if g.edgeIDs[id] != nil {
+			node.edgeIDs = make(map[K][]EdgeID, len(g.edgeIDs[id]))
+			for neighbor, edgeIDs := range g.edgeIDs[id] {
+				node.edgeIDs[neighbor] = slices.Clone(edgeIDs)
+				for _, edgeID := range edgeIDs {
+					saved.edgeEnds[edgeID] = g.edgeEnds[edgeID]
+				}
+			}
+		}
+
+		saved.nodes = append(saved.nodes, node)
+	}
+
+	return saved
+}
+
+// restoreNodes puts the nodes back into the state recorded by saveNodes,
+// dropping the ids of edges added to them since.
+func (g *Graph[K]) restoreNodes(saved txUndo[K]) {
+	for _, node := range saved.nodes {
+		for _, edgeIDs := range g.edgeIDs[node.id] {
+			for _, edgeID := range edgeIDs {
+				delete(g.edgeEnds, edgeID)
+			}
+		}
+	}
+
+	for _, node := range saved.nodes {
+		if node.exists {
+			g.nodes[node.id] = node.neighbors
+		} else {
+			delete(g.nodes, node.id)
+		}
+
+		restoreEntry(&g.weights, node.id, node.weights)
+		restoreEntry(&g.nodeAttrs, node.id, node.nodeAttrs)
+		restoreEntry(&g.edgeAttrs, node.id, node.edgeAttrs)
+		restoreEntry(&g.edgeIDs, node.id, node.edgeIDs)
+	}
+
+	for edgeID, edge := range saved.edgeEnds {
+		g.edgeEnds[edgeID] = edge
+	}
+}
+
+// restoreEntry sets the entry of the map, or deletes it if the value is nil.
+func restoreEntry[K, MK comparable, MV any, M ~map[MK]MV](m *map[K]M, key K, value M) {
+	if value == nil {
+		delete(*m, key)
+		return
+	}
+
+	if *m == nil {
+		*m = make(map[K]M)
+	}
+	(*m)[key] = value
+}
+
+// Rollback discards the queued mutations without applying any of them.
+func (tx *Tx[K]) Rollback() {
+	tx.ops = nil
+}
@@ -0,0 +1,206 @@
+package graph
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestTx_Commit(t *testing.T) {
+	tests := []struct {
+		name      string
+		queue     func(tx *Tx[int])
+		wantNodes map[int][]int
+		wantIndex int
+		wantErr   bool
+	}{
+		{
+			name: "Should apply every mutation",
+			queue: func(tx *Tx[int]) {
+				tx.AddNode(3, []int{0}).
+					AddWeightedEdge(3, 2, 4).
+					RemoveEdge(0, 1).
+					RemoveNode(1)
+			},
+			wantNodes: map[int][]int{
+				0: {3},
+				2: {3},
+				3: {0, 2},
+			},
+		},
+		{
+			name: "Should apply no mutation if one fails",
+			queue: func(tx *Tx[int]) {
+				tx.AddNode(3, []int{0}).
+					RemoveNode(1).
+					AddEdge(1, 2)
+			},
+			wantNodes: map[int][]int{
+				0: {1},
+				1: {0},
+				2: {},
+			},
+			wantIndex: 2,
+			wantErr:   true,
+		},
+		{
+			name: "Should fail on a mutation relying on an earlier failure",
+			queue: func(tx *Tx[int]) {
+				tx.AddEdge(0, 2).
+					AddNode(0, nil)
+			},
+			wantNodes: map[int][]int{
+				0: {1},
+				1: {0},
+				2: {},
+			},
+			wantIndex: 1,
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := IntGraph{
+				nodes: map[int][]int{
+					0: {1},
+					1: {0},
+					2: {},
+				},
+			}
+
+			tx := g.Begin()
+			tt.queue(tx)
+
+			err := tx.Commit()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Tx.Commit() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			var txErr *TxError
+			if tt.wantErr {
+				if !errors.As(err, &txErr) {
+					t.Fatalf("Tx.Commit() error = %v, want a *TxError", err)
+				}
+				if txErr.Index != tt.wantIndex {
+					t.Errorf("Tx.Commit() failed at operation %d (%s), want %d", txErr.Index, txErr.Op, tt.wantIndex)
+				}
+			}
+
+			for node := range g.nodes {
+				slices.Sort(g.nodes[node])
+			}
+
+			if !reflect.DeepEqual(g.nodes, tt.wantNodes) {
+				t.Errorf("Tx.Commit() Graph.nodes = %v, want %v", g.nodes, tt.wantNodes)
+			}
+		})
+	}
+}
+
+func TestTx_Rollback(t *testing.T) {
+	g := IntGraph{
+		nodes: map[int][]int{
+			0: {},
+			1: {},
+		},
+	}
+
+	tx := g.Begin().AddEdge(0, 1)
+	tx.Rollback()
+
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("Tx.Commit() error = %v", err)
+	}
+
+	want := map[int][]int{0: {}, 1: {}}
+	if !reflect.DeepEqual(g.nodes, want) {
+		t.Errorf("Tx.Rollback() Graph.nodes = %v, want %v", g.nodes, want)
+	}
+}
+
+func TestTxError_Error(t *testing.T) {
+	err := &TxError{
+		Index: 2,
+		Op:    "AddEdge(1, 2)",
+		Err:   errors.New("node 1 does not exist"),
+	}
+
+	want := "operation 2 AddEdge(1, 2) failed: node 1 does not exist"
+	if got := err.Error(); got != want {
+		t.Errorf("TxError.Error() = %q, want %q", got, want)
+	}
+}
+
+func TestTx_AddNode_CopiesConnections(t *testing.T) {
+	g := IntGraph{
+		nodes: map[int][]int{
+			0: {},
+			1: {},
+		},
+	}
+
+	connections := []int{0}
+	tx := g.Begin().AddNode(2, connections)
+	connections[0] = 1
+
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("Tx.Commit() error = %v", err)
+	}
+
+	want := map[int][]int{0: {2}, 1: {}, 2: {0}}
+	if !reflect.DeepEqual(g.nodes, want) {
+		t.Errorf("Tx.Commit() Graph.nodes = %v, want %v", g.nodes, want)
+	}
+}
+
+func TestTx_Commit_Undo(t *testing.T) {
+	g, err := New(map[int][]int{
+		0: {1, 2, 1},
+		1: {0, 0},
+		2: {0, 2, 2},
+	}, WithMultiEdges(), WithSelfLoops())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if err := g.AddWeightedEdge(1, 2, 3); err != nil {
+		t.Fatalf("Graph.AddWeightedEdge() error = %v", err)
+	}
+	if err := g.SetNodeAttr(1, "color", "red"); err != nil {
+		t.Fatalf("Graph.SetNodeAttr() error = %v", err)
+	}
+	if err := g.SetEdgeAttr(0, 1, "label", "x"); err != nil {
+		t.Fatalf("Graph.SetEdgeAttr() error = %v", err)
+	}
+	want := g.Clone()
+
+	err = g.Begin().
+		RemoveNode(1).
+		AddWeightedEdge(0, 2, 5).
+		AddNode(1, []int{2, 2}).
+		RemoveEdge(2, 2).
+		AddEdge(0, 9).
+		Commit()
+	if err == nil {
+		t.Fatalf("Tx.Commit() expected error")
+	}
+
+	if !reflect.DeepEqual(g.nodes, want.nodes) {
+		t.Errorf("Tx.Commit() Graph.nodes = %v, want %v", g.nodes, want.nodes)
+	}
+	if !reflect.DeepEqual(g.weights, want.weights) {
+		t.Errorf("Tx.Commit() Graph.weights = %v, want %v", g.weights, want.weights)
+	}
+	if !reflect.DeepEqual(g.nodeAttrs, want.nodeAttrs) || !reflect.DeepEqual(g.edgeAttrs, want.edgeAttrs) {
+		t.Errorf("Tx.Commit() attributes = %v %v, want %v %v", g.nodeAttrs, g.edgeAttrs, want.nodeAttrs, want.edgeAttrs)
+	}
+	if !reflect.DeepEqual(g.edgeIDs, want.edgeIDs) || !reflect.DeepEqual(g.edgeEnds, want.edgeEnds) || g.nextEdgeID != want.nextEdgeID {
+		t.Errorf("Tx.Commit() edge ids = %v %v %v, want %v %v %v", g.edgeIDs, g.edgeEnds, g.nextEdgeID, want.edgeIDs, want.edgeEnds, want.nextEdgeID)
+	}
+	if err := g.Validate(); err != nil {
+		t.Errorf("Graph.Validate() error = %v after undoing the batch", err)
+	}
+	if !g.Connected(1, 2) {
+		t.Errorf("Graph.Connected(1, 2) = false after undoing the batch")
+	}
+}
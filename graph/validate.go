@@ -0,0 +1,90 @@
+package graph
+
+import "fmt"
+
+// Validate checks that the graph is consistent, returning an error describing
+// the first problem found. Every neighbor must be an existing node listing the
+// node back as many times, the edges must follow the edge policy, and weights,
+// attributes and edge IDs must only refer to existing nodes and edges. The
+// graph's own methods always keep it valid, so an error means a bug, either in
+// the package or in code modifying a graph it does not own, such as a
+// ConcurrentGraph used directly.
+func (g *Graph[K]) Validate() error {
+	for node, neighbors := range g.nodes {
+		if neighbors == nil {
+			return fmt.Errorf("node %v has a nil list of neighbors", node)
+		}
+
+		multiplicity := make(map[K]int, len(neighbors))
+		for _, neighbor := range neighbors {
+			if _, ok := g.nodes[neighbor]; !ok {
+				return fmt.Errorf("node %v lists non-existent node %v", node, neighbor)
+			}
+			multiplicity[neighbor]++
+		}
+
+		for neighbor, count := range multiplicity {
+			if neighbor == node {
+				if !g.selfLoops {
+					return fmt.Errorf("self-loop on node %v is not allowed", node)
+				}
+				if count%2 != 0 {
+					return fmt.Errorf("self-loop on node %v is listed %d times, expected an even number", node, count)
+				}
+				count /= 2
+			} else if other := countOf(g.nodes[neighbor], node); other != count {
+				return fmt.Errorf("node %v lists node %v %d times, but node %v lists node %v %d times", node, neighbor, count, neighbor, node, other)
+			}
+
+			if count > 1 && !g.multiEdges {
+				return fmt.Errorf("parallel edges between node %v and node %v are not allowed", node, neighbor)
+			}
+
+			if g.multiEdges && len(g.edgeIDs[node][neighbor]) != count {
+				return fmt.Errorf("%d edges between node %v and node %v have %d IDs", count, node, neighbor, len(g.edgeIDs[node][neighbor]))
+			}
+		}
+	}
+
+	for a, weights := range g.weights {
+		for b, weight := range weights {
+			if countOf(g.nodes[a], b) == 0 {
+				return fmt.Errorf("weight is set on non-existent edge between node %v and node %v", a, b)
+			}
+			if other, ok := g.weights[b][a]; !ok || other != weight {
+				return fmt.Errorf("weight of edge between node %v and node %v is %v one way and %v the other", a, b, weight, other)
+			}
+			if weight < 0 {
+				return fmt.Errorf("weight of edge between node %v and node %v is negative", a, b)
+			}
+		}
+	}
+
+	for node := range g.nodeAttrs {
+		if _, ok := g.nodes[node]; !ok {
+			return fmt.Errorf("attributes are set on non-existent node %v", node)
+		}
+	}
+	for a, attrs := range g.edgeAttrs {
+		for b := range attrs {
+			if countOf(g.nodes[a], b) == 0 {
+				return fmt.Errorf("attributes are set on non-existent edge between node %v and node %v", a, b)
+			}
+		}
+	}
+
+	for a, ids := range g.edgeIDs {
+		for b, list := range ids {
+			if len(list) > 0 && countOf(g.nodes[a], b) == 0 {
+				return fmt.Errorf("edge IDs are set on non-existent edge between node %v and node %v", a, b)
+			}
+			for _, id := range list {
+				if ends, ok := g.edgeEnds[id]; !ok || (ends != Edge[K]{A: a, B: b} && ends != Edge[K]{A: b, B: a}) {
+					return fmt.Errorf("edge ID %v between node %v and node %v does not lead back to them", id, a, b)
+				}
+			}
+		}
+	}
+
+	return nil
+}
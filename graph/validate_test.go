@@ -0,0 +1,320 @@
+package graph
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestGraph_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		graph   IntGraph
+		wantErr bool
+	}{
+		{
+			name: "Should accept a valid graph",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1, 2},
+					1: {0},
+					2: {0},
+				},
+				weights: map[int]map[int]float64{
+					0: {1: 2},
+					1: {0: 2},
+				},
+			},
+		},
+		{
+			name: "Should return error if a neighbor does not exist",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1, 2},
+					1: {0},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should return error if a relation is not mutual",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1},
+					1: {},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should return error for parallel edges in a simple graph",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1, 1},
+					1: {0, 0},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should return error for a self-loop listed once",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {0},
+				},
+				selfLoops: true,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should return error for the weight of a non-existent edge",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {},
+					1: {},
+				},
+				weights: map[int]map[int]float64{
+					0: {1: 2},
+					1: {0: 2},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should return error for attributes of a non-existent node",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {},
+				},
+				nodeAttrs: map[int]map[string]any{
+					1: {"name": "gone"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should return error for parallel edges without IDs in a multigraph",
+			graph: IntGraph{
+				nodes: map[int][]int{
+					0: {1, 1},
+					1: {0, 0},
+				},
+				multiEdges: true,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.graph.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Graph.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// fuzzNodes is the number of distinct node ids fuzzed operations pick from.
+const fuzzNodes = 8
+
+// FuzzGraphMutations runs the sequence of mutations encoded by the input on a
+// graph and on a plain model of it, checking after every step that the graph
+// is valid, matches the model, and finds paths along edges of the model as
+// short as those of a breadth-first search on it. The first byte picks the
+// edge policy, then every three bytes encode an operation and its arguments.
+func FuzzGraphMutations(f *testing.F) {
+	f.Add([]byte{0, 0, 0, 0b110, 2, 1, 2, 2, 3, 0, 3, 1, 2})
+	f.Add([]byte{1, 0, 0, 0, 0, 9, 0b11, 2, 1, 0, 2, 1, 0, 3, 1, 0})
+	f.Add([]byte{3, 0, 2, 0, 2, 2, 2, 2, 2, 2, 3, 2, 2, 1, 2, 0})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if len(data) == 0 {
+			return
+		}
+
+		var opts []Option
+		multiEdges, selfLoops := data[0]&1 != 0, data[0]&2 != 0
+		if multiEdges {
+			opts = append(opts, WithMultiEdges())
+		}
+		if selfLoops {
+			opts = append(opts, WithSelfLoops())
+		}
+
+		g, err := New(map[int][]int{}, opts...)
+		if err != nil {
+			t.Fatalf("New() error = %v", err)
+		}
+
+		// model holds the number of edges between every pair of nodes, both
+		// ways, a self-loop counting once
+		model := make(map[int]map[int]int)
+
+		for ops := data[1:]; len(ops) >= 3; ops = ops[3:] {
+			a, b := int(ops[1]%fuzzNodes), int(ops[2]%fuzzNodes)
+
+			var op string
+			var wantOK bool
+			switch ops[0] % 4 {
+			case 0:
+				// The bits of the third byte pick the connections, and a
+				// high bit of the second byte repeats the first one
+				var connections []int
+				for node := range fuzzNodes {
+					if ops[2]&(1<<node) != 0 {
+						connections = append(connections, node)
+					}
+				}
+				if ops[1]&0x80 != 0 && len(connections) > 0 {
+					connections = append(connections, connections[0])
+				}
+
+				op = fmt.Sprintf("AddNode(%v, %v)", a, connections)
+				_, exists := model[a]
+				wantOK = !exists
+				for i, connection := range connections {
+					if _, ok := model[connection]; !ok || connection == a {
+						wantOK = false
+					}
+					for _, previous := range connections[:i] {
+						if previous == connection && !multiEdges {
+							wantOK = false
+						}
+					}
+				}
+
+				err = g.AddNode(a, connections)
+				if err == nil {
+					model[a] = make(map[int]int)
+					for _, connection := range connections {
+						model[a][connection]++
+						model[connection][a]++
+					}
+				}
+			case 1:
+				op = fmt.Sprintf("RemoveNode(%v)", a)
+				_, wantOK = model[a]
+
+				err = g.RemoveNode(a)
+				if err == nil {
+					for neighbor := range model[a] {
+						delete(model[neighbor], a)
+					}
+					delete(model, a)
+				}
+			case 2:
+				op = fmt.Sprintf("AddEdge(%v, %v)", a, b)
+				_, aExists := model[a]
+				_, bExists := model[b]
+				wantOK = aExists && bExists && (a != b || selfLoops) && (model[a][b] == 0 || multiEdges)
+
+				err = g.AddEdge(a, b)
+				if err == nil {
+					model[a][b]++
+					if a != b {
+						model[b][a]++
+					}
+				}
+			case 3:
+				op = fmt.Sprintf("RemoveEdge(%v, %v)", a, b)
+				wantOK = model[a][b] > 0
+
+				err = g.RemoveEdge(a, b)
+				if err == nil {
+					delete(model[a], b)
+					delete(model[b], a)
+				}
+			}
+
+			if (err == nil) != wantOK {
+				t.Fatalf("Graph.%v error = %v, want success %v", op, err, wantOK)
+			}
+
+			if err := g.Validate(); err != nil {
+				t.Fatalf("Graph.Validate() after Graph.%v error = %v", op, err)
+			}
+
+			if len(g.nodes) != len(model) {
+				t.Fatalf("after Graph.%v graph has %v nodes, want %v", op, len(g.nodes), len(model))
+			}
+			for node, edges := range model {
+				for neighbor, count := range edges {
+					if neighbor == node {
+						count *= 2
+					}
+					if got := countOf(g.nodes[node], neighbor); got != count {
+						t.Fatalf("after Graph.%v node %v lists node %v %v times, want %v", op, node, neighbor, got, count)
+					}
+				}
+				if len(g.nodes[node]) != degreeOf(edges, node) {
+					t.Fatalf("after Graph.%v node %v has neighbors %v, want %v", op, node, g.nodes[node], edges)
+				}
+			}
+
+			for from := range model {
+				dist := referenceDistances(model, from)
+				for to := range model {
+					path, err := g.FindShortestPath(from, to)
+
+					// A path always leaves its start node, so none leads from
+					// a node to itself
+					want, reachable := dist[to]
+					if g.Connected(from, to) != reachable {
+						t.Fatalf("Graph.Connected(%v, %v) = %v after Graph.%v, want %v", from, to, !reachable, op, reachable)
+					}
+
+					reachable = reachable && from != to
+					if (err == nil) != reachable {
+						t.Fatalf("Graph.FindShortestPath(%v, %v) error = %v, want reachable %v", from, to, err, reachable)
+					}
+					if err != nil {
+						continue
+					}
+
+					if len(path) != want+1 {
+						t.Fatalf("Graph.FindShortestPath(%v, %v) = %v, want %v edges", from, to, path, want)
+					}
+					if path[0] != from || path[len(path)-1] != to {
+						t.Fatalf("Graph.FindShortestPath(%v, %v) = %v, does not connect the nodes", from, to, path)
+					}
+					for i := 1; i < len(path); i++ {
+						if model[path[i-1]][path[i]] == 0 {
+							t.Fatalf("Graph.FindShortestPath(%v, %v) = %v, %v and %v are not connected", from, to, path, path[i-1], path[i])
+						}
+					}
+				}
+			}
+		}
+	})
+}
+
+// degreeOf returns the number of neighbors a node with the given edges lists.
+func degreeOf(edges map[int]int, node int) int {
+	degree := 0
+	for neighbor, count := range edges {
+		if neighbor == node {
+			count *= 2
+		}
+		degree += count
+	}
+
+	return degree
+}
+
+// referenceDistances returns the number of edges on the shortest path from
+// the node to every node reachable from it in the model.
+func referenceDistances(model map[int]map[int]int, from int) map[int]int {
+	dist := map[int]int{from: 0}
+	queue := []int{from}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+
+		for neighbor := range model[node] {
+			if _, ok := dist[neighbor]; !ok {
+				dist[neighbor] = dist[node] + 1
+				queue = append(queue, neighbor)
+			}
+		}
+	}
+
+	return dist
+}